@@ -14,6 +14,19 @@ sys.path.insert(0, os.path.join(os.path.dirname(__file__), 'src'))
 from src.gui.app import run_app
 
 if __name__ == "__main__":
+    # Print just the number of due problems, for status bars and widgets
+    if sys.argv[1:] == ["--due-count"]:
+        from src.database.db_manager import DatabaseManager
+        print(DatabaseManager().count_due_problems())
+        sys.exit(0)
+    
+    # Send due summaries and streak warnings to webhooks, for cron jobs
+    if sys.argv[1:] == ["--notify"]:
+        from src.database.db_manager import DatabaseManager
+        from src.utils.notifications import send_scheduled_events
+        send_scheduled_events(DatabaseManager())
+        sys.exit(0)
+    
     try:
         run_app()
     except KeyboardInterrupt:
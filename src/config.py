@@ -43,11 +43,329 @@ def get_db_path() -> Path:
     data_dir.mkdir(parents=True, exist_ok=True)
     return data_dir / DB_NAME
 
+def get_cache_dir() -> Path:
+    """
+    Get the directory used for cached data fetched from the network.
+    
+    Returns:
+        Path: Cache directory inside the data directory
+    """
+    cache_dir = get_data_dir() / "cache"
+    cache_dir.mkdir(parents=True, exist_ok=True)
+    return cache_dir
+
+# Marker identifying shared problem deck files
+DECK_FORMAT = "dsarecall-deck"
+
+# Days a deleted problem stays in the trash before it is purged for good
+TRASH_RETENTION_DAYS = 30
+
+# File storage backend (set DSARECALL_STORAGE to choose another one)
+STORAGE_BACKENDS = ["local"]
+STORAGE_BACKEND = os.environ.get("DSARECALL_STORAGE", "local").lower()
+
+# Storage area that attached files are kept in
+ATTACHMENTS_AREA = "attachments"
+
+# Attachment limits (file extension -> MIME type of the allowed files)
+ATTACHMENT_MAX_BYTES = 10 * 1024 * 1024
+ATTACHMENT_TYPES = {
+    ".png": "image/png",
+    ".jpg": "image/jpeg",
+    ".jpeg": "image/jpeg",
+    ".gif": "image/gif",
+    ".webp": "image/webp",
+    ".svg": "image/svg+xml",
+    ".pdf": "application/pdf",
+}
+
+# Network configuration (set DSARECALL_OFFLINE=1 to disable all outbound calls)
+OFFLINE_MODE = os.environ.get("DSARECALL_OFFLINE", "").lower() in ("1", "true", "yes")
+LEETCODE_GRAPHQL_URL = "https://leetcode.com/graphql"
+NETWORK_TIMEOUT_SECONDS = 5
+
 # Spaced repetition constants
 INITIAL_STREAK_LEVEL = 1
 INITIAL_INTERVAL_DAYS = 1
 STREAK_MULTIPLIER = 2
 
+# Review scheduling algorithms ('streak' doubles the interval each Easy,
+# 'leitner' moves problems through boxes with fixed intervals, 'fsrs'
+# models how well each problem is remembered)
+SCHEDULERS = ["streak", "leitner", "fsrs"]
+
+# Review interval in days for each Leitner box, from the first box to the last
+LEITNER_BOX_INTERVALS = [1, 3, 7, 14, 30]
+
+# Default FSRS (Free Spaced Repetition Scheduler) parameters, used until
+# they are optimized from the user's own review history
+FSRS_DEFAULT_WEIGHTS = [
+    0.4872, 1.4003, 3.7145, 13.8206, 5.1618, 1.2298, 0.8975, 0.031, 1.6474,
+    0.1367, 1.0461, 2.1072, 0.0793, 0.3246, 1.587, 0.2272, 2.8755,
+]
+
+# Graded reviews needed before FSRS parameters are optimized
+FSRS_MIN_REVIEWS = 50
+
+# Problem statuses: inbox problems are captured but not yet scheduled,
+# archived problems are kept but taken out of the review rotation
+STATUS_ACTIVE = "active"
+STATUS_INBOX = "inbox"
+STATUS_ARCHIVED = "archived"
+
+# Next-review buckets shown on the dashboard, in display order with labels.
+# Suspended problems are the ones not on the schedule (inbox or archived).
+REVIEW_BUCKETS = {
+    "overdue": "Overdue",
+    "today": "Today",
+    "next_7_days": "Next 7 days",
+    "later": "Later",
+    "suspended": "Suspended",
+}
+
+# Problem difficulty levels and the extra delay before a new problem's
+# first review (easier problems can wait longer)
+DIFFICULTY_LEVELS = ["Easy", "Medium", "Hard"]
+DIFFICULTY_DELAY_OFFSET_DAYS = {
+    "Easy": 2,
+    "Medium": 1,
+    "Hard": 0,
+}
+
+# Known problem sources, keyed by the domain of their problem links
+# (subdomains match too, e.g. open.kattis.com)
+PROBLEM_SOURCES = {
+    "leetcode.com": "LeetCode",
+    "leetcode.cn": "LeetCode",
+    "codeforces.com": "Codeforces",
+    "cses.fi": "CSES",
+    "atcoder.jp": "AtCoder",
+    "codechef.com": "CodeChef",
+    "hackerrank.com": "HackerRank",
+    "geeksforgeeks.org": "GeeksforGeeks",
+    "interviewbit.com": "InterviewBit",
+    "spoj.com": "SPOJ",
+    "kattis.com": "Kattis",
+    "neetcode.io": "NeetCode",
+}
+
+# Companies suggested when tagging problems; names matching one of these
+# (ignoring case) are stored with this spelling
+KNOWN_COMPANIES = [
+    "Google", "Amazon", "Meta", "Microsoft", "Apple", "Netflix", "Bloomberg", "Uber",
+    "Adobe", "Airbnb", "LinkedIn", "Oracle", "Salesforce", "Goldman Sachs", "TikTok",
+    "Stripe", "Nvidia", "Atlassian", "Flipkart", "Walmart",
+]
+
+# Fields the problem list can be sorted by, mapped to their SQL expression.
+# Only these are ever put into an ORDER BY clause. Problems have no
+# creation date, but IDs increase in the order they were added.
+PROBLEM_SORT_FIELDS = {
+    "added": "id",
+    "title": "title COLLATE NOCASE",
+    "next_review": "next_review",
+    "streak": "streak_level",
+    "last_marked": "last_marked",
+}
+SORT_ORDERS = ["asc", "desc"]
+
+# Number of rows shown per page in long lists, and the largest allowed page
+DEFAULT_PAGE_SIZE = 20
+MAX_PAGE_SIZE = 100
+
+# Default number of due problems in a batch review
+BATCH_REVIEW_SIZE = 10
+
+# Default and longest length of a focus session, in minutes
+FOCUS_DEFAULT_MINUTES = 25
+FOCUS_MAX_MINUTES = 180
+
+# Possible results of a recorded interview round
+INTERVIEW_OUTCOMES = ["Pending", "Passed", "Failed"]
+
+# Longest a problem can be snoozed in one go
+SNOOZE_MAX_DAYS = 365
+
+# How journal entry timestamps are stored and shown
+JOURNAL_TIMESTAMP_FORMAT = "%Y-%m-%d %H:%M"
+
+# Graded reviews needed before a problem's difficulty trend is shown
+TREND_MIN_REVIEWS = 4
+
+# Notification kinds, and the events that trigger them
+NOTIFY_IMPORT_FINISHED = "import_finished"
+NOTIFY_EXPORT_FINISHED = "export_finished"
+NOTIFY_STREAK_MILESTONE = "streak_milestone"
+NOTIFY_LEECH = "leech"
+
+# Events that can be sent to outgoing webhooks: the notification kinds
+# above plus reviews, the daily due reminder and a lost streak
+EVENT_REVIEW_COMPLETED = "review_completed"
+EVENT_PROBLEMS_DUE = "problems_due"
+EVENT_STREAK_BROKEN = "streak_broken"
+EVENT_STREAK_AT_RISK = "streak_at_risk"
+WEBHOOK_EVENTS = [
+    EVENT_REVIEW_COMPLETED, EVENT_PROBLEMS_DUE, EVENT_STREAK_BROKEN, EVENT_STREAK_AT_RISK,
+    NOTIFY_STREAK_MILESTONE, NOTIFY_LEECH, NOTIFY_IMPORT_FINISHED, NOTIFY_EXPORT_FINISHED,
+]
+
+# Failed webhook deliveries are retried after 1, 2, 4, ... minutes and
+# given up after WEBHOOK_MAX_ATTEMPTS tries
+WEBHOOK_MAX_ATTEMPTS = 5
+WEBHOOK_RETRY_BASE_SECONDS = 60
+
+# Header carrying the HMAC-SHA256 signature of a webhook request body
+WEBHOOK_SIGNATURE_HEADER = "X-DSARecall-Signature"
+
+# Where webhook events can be sent: signed JSON to any URL, or a chat message
+CHANNELS = ["webhook", "discord", "telegram"]
+TELEGRAM_API_URL = "https://api.telegram.org"
+
+# From this hour on, a day without reviews sends a streak_at_risk warning
+STREAK_RISK_HOUR = 18
+
+# Problems named in a due-summary chat message (the rest are counted)
+DUE_SUMMARY_TITLES = 5
+
+# Streak lengths (in days) that are celebrated with a notification
+STREAK_MILESTONES = [7, 30, 50, 100, 200, 365]
+
+# Every this many streak days earn a streak freeze, which is spent
+# automatically to cover a missed day so the streak survives it
+STREAK_FREEZE_EARN_DAYS = 7
+
+# Most unused streak freezes that can be held at once
+STREAK_FREEZE_MAX = 2
+
+# A problem marked Hard this many times is flagged as a leech, i.e. one
+# that keeps being forgotten and probably needs a fresh look
+LEECH_HARD_COUNT = 5
+
+# A problem marked Easy this many times in a row, with at least this many
+# days between its last review and the next one, is suggested as mastered
+MASTERY_EASY_REVIEWS = 5
+MASTERY_MIN_INTERVAL_DAYS = 30
+
+# Weekday names, indexed like date.weekday() (Monday = 0)
+WEEKDAY_NAMES = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"]
+
+# User-adjustable settings stored in the database, with their defaults.
+# The type of each default is also the type the stored value is read as.
+DEFAULT_SETTINGS = {
+    "initial_delay_days": INITIAL_INTERVAL_DAYS,
+    "first_success_interval_days": STREAK_MULTIPLIER ** (INITIAL_STREAK_LEVEL + 1),
+    "easy_bonus": 1.0,
+    "max_interval_days": 0,
+    "hard_interval_days": INITIAL_INTERVAL_DAYS,
+    "lapse_behavior": "reset",
+    "daily_new_cap": 10,
+    "max_reviews_per_day": 0,
+    "week_start": "Monday",
+    "holidays": "",
+    "scheduler": "streak",
+    "desired_retention": 0.9,
+    "fsrs_weights": "",
+    "page_size": DEFAULT_PAGE_SIZE,
+    "default_sort": "added",
+    "default_sort_order": "asc",
+}
+
+SETTING_LABELS = {
+    "initial_delay_days": "Days before a new problem's first review (0 = same day)",
+    "first_success_interval_days": "Days until the next review after the first Easy",
+    "easy_bonus": "Multiplier for the interval after later Easy reviews (1.0 = 2^streak days)",
+    "max_interval_days": "Longest interval between reviews in days (0 = no limit)",
+    "hard_interval_days": "Days until the next review after a Hard (0 = same day)",
+    "lapse_behavior": "What Hard does to the streak (reset = back to the start, step_back = one level down)",
+    "daily_new_cap": "Max new problems scheduled for their first review per day (0 = no limit)",
+    "max_reviews_per_day": "Max reviews per day, extra due problems wait for the next day (0 = no limit)",
+    "week_start": "First day of the week in the activity calendar",
+    "holidays": "Recurring no-review days, comma-separated (e.g. Sunday; 'none' to clear)",
+    "scheduler": "Review scheduling algorithm (streak = doubling intervals, leitner = fixed boxes, fsrs = memory model)",
+    "desired_retention": "FSRS: chance of still remembering a problem when it comes up (e.g. 0.9)",
+    "fsrs_weights": "FSRS: model parameters, comma-separated ('default' to reset, or use Optimize)",
+    "page_size": f"Problems per page in the problem list (1-{MAX_PAGE_SIZE})",
+    "default_sort": "Field the problem list is sorted by when opened",
+    "default_sort_order": "Order the problem list is sorted in when opened",
+}
+
+# Allowed values for settings that are picked from a fixed list
+SETTING_CHOICES = {
+    "week_start": ["Monday", "Sunday"],
+    "lapse_behavior": ["reset", "step_back"],
+    "scheduler": SCHEDULERS,
+    "default_sort": list(PROBLEM_SORT_FIELDS),
+    "default_sort_order": SORT_ORDERS,
+}
+
+# File extensions for code in each programming language
+LANGUAGE_EXTENSIONS = {
+    "python": ".py",
+    "java": ".java",
+    "cpp": ".cpp",
+    "c": ".c",
+    "javascript": ".js",
+    "typescript": ".ts",
+    "go": ".go",
+    "rust": ".rs",
+    "ruby": ".rb",
+    "php": ".php",
+    "swift": ".swift",
+    "kotlin": ".kt",
+    "scala": ".scala"
+}
+
+# Other names accepted for supported languages (alias -> language)
+LANGUAGE_ALIASES = {
+    "py": "python",
+    "python3": "python",
+    "c++": "cpp",
+    "cc": "cpp",
+    "js": "javascript",
+    "node": "javascript",
+    "ts": "typescript",
+    "golang": "go",
+    "rs": "rust",
+    "rb": "ruby",
+    "kt": "kotlin",
+}
+
+# Longest problem title accepted, and the link schemes problems can point to
+PROBLEM_TITLE_MAX_LENGTH = 200
+LINK_SCHEMES = ["http", "https"]
+
+# Language assumed for a problem's own code, which has no language field
+DEFAULT_CODE_LANGUAGE = "cpp"
+
+# Tag taxonomy used for automatic tag suggestions (tag -> keywords)
+TAG_TAXONOMY = {
+    "array": ["array", "subarray", "prefix sum", "kadane"],
+    "string": ["string", "substring", "palindrome", "anagram", "character"],
+    "hash-table": ["hash", "hashmap", "hash map", "dictionary", "dict", "hashset"],
+    "two-pointers": ["two pointer", "two-pointer", "left and right pointer", "fast and slow"],
+    "sliding-window": ["sliding window", "window"],
+    "binary-search": ["binary search", "bisect", "lower bound", "upper bound"],
+    "sorting": ["sort", "sorted", "merge sort", "quicksort"],
+    "stack": ["stack", "monotonic stack", "parenthes"],
+    "queue": ["queue", "deque"],
+    "heap": ["heap", "priority queue", "heapq", "top k", "kth largest", "kth smallest"],
+    "linked-list": ["linked list", "listnode", "next pointer"],
+    "tree": ["tree", "binary tree", "bst", "inorder", "preorder", "postorder", "treenode"],
+    "graph": ["graph", "adjacency", "edges", "vertices", "topological", "dijkstra"],
+    "bfs": ["bfs", "breadth-first", "breadth first", "level order"],
+    "dfs": ["dfs", "depth-first", "depth first", "backtrack"],
+    "union-find": ["union find", "union-find", "disjoint set", "dsu"],
+    "trie": ["trie", "prefix tree"],
+    "dynamic-programming": ["dynamic programming", "dp", "memoization", "memoize", "tabulation", "knapsack"],
+    "greedy": ["greedy"],
+    "backtracking": ["backtracking", "backtrack", "permutation", "combination", "subsets"],
+    "bit-manipulation": ["bit manipulation", "xor", "bitmask", "bitwise"],
+    "math": ["math", "modulo", "gcd", "prime", "factorial"],
+    "recursion": ["recursion", "recursive"],
+    "intervals": ["interval", "overlapping", "merge intervals"],
+    "matrix": ["matrix", "grid", "2d array"],
+}
+
 # UI Constants
 MAIN_MENU_OPTIONS = [
     "➕ Add Problem",
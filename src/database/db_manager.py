@@ -2,16 +2,31 @@
 Database manager for DSA Recall application.
 
 This module provides high-level database operations for managing
-DSA problems and tracking review streaks.
+DSA problems, their solutions, study notes and tracking review streaks.
 """
 
+import json
 import sqlite3
-from datetime import date, timedelta
-from typing import List, Optional, Dict, Any
+from datetime import date, datetime, timedelta
+from typing import List, Optional, Dict, Any, Iterator, Tuple
 from contextlib import contextmanager
 
-from src.config import get_db_path
-from .models import Problem, create_database_schema, problem_from_row
+from src.config import (
+    get_db_path, ATTACHMENTS_AREA, STATUS_ACTIVE, STATUS_INBOX, STATUS_ARCHIVED, DEFAULT_SETTINGS, REVIEW_BUCKETS,
+    PROBLEM_SORT_FIELDS, SORT_ORDERS, TRASH_RETENTION_DAYS,
+    JOURNAL_TIMESTAMP_FORMAT
+)
+from .models import (
+    Problem, Solution, JournalEntry, Note, Notification, Interview, FocusSession, Attachment, Collection,
+    Webhook, WebhookDelivery, create_database_schema, problem_from_row, solution_from_row, journal_entry_from_row,
+    note_from_row, notification_from_row, interview_from_row, focus_session_from_row, attachment_from_row,
+    collection_from_row, webhook_from_row, webhook_delivery_from_row, normalize_search_text, split_tags,
+    normalize_link
+)
+from src.storage.factory import get_storage
+from src.utils.validation import validate_problem, validate_solution
+from src.utils.streaks import StreakService
+from src.errors import ValidationError, NotFoundError
 
 
 class DatabaseManager:
@@ -44,11 +59,35 @@ class DatabaseManager:
         """
         conn = sqlite3.connect(self.db_path)
         conn.row_factory = sqlite3.Row
+        # SQLite's LIKE only folds ASCII case, so searches use this instead
+        conn.create_function('normalize', 1, normalize_search_text)
+        # Link lookups compare links the same way the Python code does
+        conn.create_function('normalize_link', 1, normalize_link)
         try:
             yield conn
         finally:
             conn.close()
     
+    @contextmanager
+    def _transaction(self):
+        """
+        Context manager running several writes as one transaction.
+        
+        Everything written through the cursor is committed together when
+        the block finishes, or rolled back if it raises.
+        
+        Yields:
+            sqlite3.Cursor: Cursor on a new database connection
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            try:
+                yield cursor
+                conn.commit()
+            except Exception:
+                conn.rollback()
+                raise
+    
     def add_problem(self, problem: Problem) -> int:
         """
         Add a new problem to the database.
@@ -58,21 +97,32 @@ class DatabaseManager:
             
         Returns:
             int: ID of the newly created problem
+            
+        Raises:
+            ValidationError: If the title, link or difficulty is invalid
         """
+        validate_problem(problem)
         with self._get_connection() as conn:
             cursor = conn.cursor()
             cursor.execute('''
-                INSERT INTO problems (title, link, approach, code, streak_level, next_review, last_marked, history)
-                VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+                INSERT INTO problems (title, link, approach, code, tags, difficulty, source, companies, status,
+                                      streak_level, next_review, last_marked, history, scheduler_state)
+                VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
             ''', (
                 problem.title,
                 problem.link,
                 problem.approach,
                 problem.code,
+                problem.tags,
+                problem.difficulty,
+                problem.source,
+                problem.companies,
+                problem.status,
                 problem.streak_level,
                 problem.next_review.isoformat() if problem.next_review else None,
                 problem.last_marked.isoformat() if problem.last_marked else None,
-                problem.history
+                problem.history,
+                problem.scheduler_state
             ))
             conn.commit()
             return cursor.lastrowid
@@ -93,22 +143,104 @@ class DatabaseManager:
             row = cursor.fetchone()
             return problem_from_row(row) if row else None
     
-    def get_all_problems(self) -> List[Problem]:
+    def get_all_problems(self, difficulty: str = None, include_archived: bool = True,
+                         sort: str = "added", order: str = "asc",
+                         source: str = None, company: str = None) -> List[Problem]:
         """
         Retrieve all problems from the database.
         
+        Args:
+            difficulty: Only return problems with this difficulty (optional)
+            include_archived: Whether archived problems are included
+            sort: Field to sort by, one of PROBLEM_SORT_FIELDS
+            order: 'asc' or 'desc'
+            source: Only return problems from this source, ignoring case (optional)
+            company: Only return problems asked by this company, ignoring case (optional)
+        
         Returns:
             List of all Problem instances
+            
+        Raises:
+            ValidationError: If the sort field or order is unknown
+        """
+        if sort not in PROBLEM_SORT_FIELDS:
+            raise ValidationError(f"Unknown sort field '{sort}'")
+        if order not in SORT_ORDERS:
+            raise ValidationError(f"Unknown sort order '{order}'")
+        
+        conditions = []
+        params = []
+        if difficulty:
+            conditions.append('difficulty = ?')
+            params.append(difficulty)
+        if not include_archived:
+            conditions.append('status != ?')
+            params.append(STATUS_ARCHIVED)
+        if source:
+            conditions.append('source = ? COLLATE NOCASE')
+            params.append(source)
+        where = f"WHERE {' AND '.join(conditions)}" if conditions else ""
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            # Problems without a date sort last, and ties keep the order they were added in
+            cursor.execute(f'''
+                SELECT * FROM problems {where}
+                ORDER BY {PROBLEM_SORT_FIELDS[sort]} IS NULL, {PROBLEM_SORT_FIELDS[sort]} {order.upper()}, id
+            ''', params)
+            problems = [problem_from_row(row) for row in cursor.fetchall()]
+        
+        # Companies are stored as comma-separated text, so they're matched here
+        if company:
+            problems = [problem for problem in problems
+                        if company.lower() in (name.lower() for name in problem.company_list)]
+        return problems
+    
+    def iter_problems(self) -> Iterator[Problem]:
+        """
+        Iterate over all problems without loading them all into memory.
+        
+        Yields:
+            Problem instances ordered by ID
         """
         with self._get_connection() as conn:
             cursor = conn.cursor()
             cursor.execute('SELECT * FROM problems ORDER BY id')
-            return [problem_from_row(row) for row in cursor.fetchall()]
+            for row in cursor:
+                yield problem_from_row(row)
+    
+    def find_problem_by_link(self, link: str) -> Optional[Problem]:
+        """
+        Find a problem with the given link.
+        
+        Surrounding whitespace and a trailing slash are ignored, so
+        '.../two-sum' and '.../two-sum/' are treated as the same link.
+        
+        Args:
+            link: Link to look for
+            
+        Returns:
+            Problem instance if found, None otherwise
+        """
+        normalized = normalize_link(link)
+        if not normalized:
+            return None
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'SELECT * FROM problems WHERE normalize_link(link) = ? ORDER BY id LIMIT 1',
+                (normalized,)
+            )
+            row = cursor.fetchone()
+            return problem_from_row(row) if row else None
     
     def get_due_problems(self, target_date: date = None) -> List[Problem]:
         """
         Retrieve problems that are due for review.
         
+        Only active problems are scheduled, so inbox problems are never due.
+        
         Args:
             target_date: Date to check for due problems (defaults to today)
             
@@ -121,9 +253,246 @@ class DatabaseManager:
         with self._get_connection() as conn:
             cursor = conn.cursor()
             cursor.execute(
-                'SELECT * FROM problems WHERE next_review <= ? ORDER BY next_review',
-                (target_date.isoformat(),)
+                'SELECT * FROM problems WHERE status = ? AND next_review <= ? ORDER BY next_review, last_marked, id',
+                (STATUS_ACTIVE, target_date.isoformat())
+            )
+            return [problem_from_row(row) for row in cursor.fetchall()]
+    
+    def get_review_queue(self) -> Tuple[List[Problem], int]:
+        """
+        Retrieve today's review queue, capped by the daily review limit.
+        
+        Reviews already done today count towards the max_reviews_per_day
+        setting, so the queue only holds what is left of today's limit.
+        
+        Returns:
+            Tuple of (due problems to review today, earliest due and
+            longest unreviewed first; number of further due problems over
+            the limit)
+        """
+        due_problems = self.get_due_problems()
+        remaining = self.get_remaining_reviews_today()
+        if remaining is None:
+            return due_problems, 0
+        return due_problems[:remaining], max(0, len(due_problems) - remaining)
+    
+    def get_remaining_reviews_today(self) -> Optional[int]:
+        """
+        Count the reviews left before today's review limit is reached.
+        
+        Returns:
+            int: Reviews left today, or None if there is no daily limit
+        """
+        daily_limit = self.get_settings()['max_reviews_per_day']
+        if daily_limit <= 0:
+            return None
+        reviewed_today = self.get_activity_range(date.today(), date.today())[0]['problems_reviewed']
+        return max(0, daily_limit - reviewed_today)
+    
+    def count_due_problems(self, target_date: date = None) -> int:
+        """
+        Count problems that are due for review without loading them.
+        
+        Today's count is capped at the reviews left under the daily
+        review limit, like the review queue.
+        
+        Args:
+            target_date: Date to check for due problems (defaults to today)
+            
+        Returns:
+            int: Number of active problems due on or before the date
+        """
+        if target_date is None:
+            target_date = date.today()
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'SELECT COUNT(*) FROM problems WHERE status = ? AND next_review <= ?',
+                (STATUS_ACTIVE, target_date.isoformat())
             )
+            count = cursor.fetchone()[0]
+        
+        remaining = self.get_remaining_reviews_today() if target_date == date.today() else None
+        return count if remaining is None else min(count, remaining)
+    
+    def get_review_bucket_counts(self, target_date: date = None) -> Dict[str, int]:
+        """
+        Count problems by when they are next due, in a single grouped query.
+        
+        Args:
+            target_date: Date the buckets are relative to (defaults to today)
+            
+        Returns:
+            dict: Bucket name (see REVIEW_BUCKETS) to number of problems
+        """
+        if target_date is None:
+            target_date = date.today()
+        
+        today = target_date.isoformat()
+        week_end = (target_date + timedelta(days=7)).isoformat()
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT CASE
+                           WHEN status != ? THEN 'suspended'
+                           WHEN next_review < ? THEN 'overdue'
+                           WHEN next_review = ? THEN 'today'
+                           WHEN next_review <= ? THEN 'next_7_days'
+                           ELSE 'later'
+                       END AS bucket,
+                       COUNT(*) AS problem_count
+                FROM problems
+                GROUP BY bucket
+            ''', (STATUS_ACTIVE, today, today, week_end))
+            counts = {bucket: 0 for bucket in REVIEW_BUCKETS}
+            counts.update({row['bucket']: row['problem_count'] for row in cursor.fetchall()})
+            return counts
+    
+    def get_untagged_problems(self) -> List[Problem]:
+        """
+        Retrieve problems that have no tags yet.
+        
+        Returns:
+            List of untagged Problem instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute("SELECT * FROM problems WHERE tags IS NULL OR TRIM(tags) = '' ORDER BY id")
+            return [problem_from_row(row) for row in cursor.fetchall()]
+    
+    def get_problems_needing_attention(self) -> List[Problem]:
+        """
+        Retrieve problems that are missing tags, difficulty, approach or code.
+        
+        Returns:
+            List of incomplete Problem instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT * FROM problems
+                WHERE tags IS NULL OR TRIM(tags) = ''
+                   OR difficulty IS NULL OR difficulty = ''
+                   OR approach IS NULL OR TRIM(approach) = ''
+                   OR code IS NULL OR TRIM(code) = ''
+                ORDER BY id
+            ''')
+            return [problem_from_row(row) for row in cursor.fetchall()]
+    
+    def search_problems(self, query: str) -> List[Problem]:
+        """
+        Find problems whose title, link, approach or tags contain the query.
+        
+        Matching is case-insensitive and Unicode-normalized (see
+        normalize_search_text).
+        
+        Args:
+            query: Text to search for
+            
+        Returns:
+            List of matching Problem instances
+        """
+        needle = normalize_search_text(query)
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT * FROM problems
+                WHERE instr(normalize(title), ?) OR instr(normalize(link), ?)
+                   OR instr(normalize(approach), ?) OR instr(normalize(tags), ?)
+                ORDER BY id
+            ''', (needle, needle, needle, needle))
+            return [problem_from_row(row) for row in cursor.fetchall()]
+    
+    def get_new_problem_counts(self, start_date: date = None) -> Dict[date, int]:
+        """
+        Count never-reviewed problems scheduled on each day.
+        
+        Args:
+            start_date: First day to include (defaults to today)
+            
+        Returns:
+            dict: Date to number of new problems due on that date
+        """
+        if start_date is None:
+            start_date = date.today()
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT next_review, COUNT(*) AS problem_count
+                FROM problems
+                WHERE status = ? AND last_marked IS NULL AND next_review >= ?
+                GROUP BY next_review
+            ''', (STATUS_ACTIVE, start_date.isoformat()))
+            return {date.fromisoformat(row['next_review']): row['problem_count']
+                    for row in cursor.fetchall()}
+    
+    def get_due_forecast(self, days: int = 30) -> List[Dict[str, Any]]:
+        """
+        Count the problems that come due on each of the next few days.
+        
+        Overdue problems are counted on today, since that's when they
+        will be reviewed.
+        
+        Args:
+            days: Number of days to forecast, starting today (1 to 365)
+            
+        Returns:
+            List of dictionaries with date (as a date) and due count, one
+            per day in ascending order, including days with nothing due
+            
+        Raises:
+            ValidationError: If days is outside 1 to 365
+        """
+        if not 1 <= days <= 365:
+            raise ValidationError("The forecast must cover 1 to 365 days")
+        
+        today = date.today()
+        end_date = today + timedelta(days=days - 1)
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT MAX(next_review, ?) AS due_date, COUNT(*) AS problem_count
+                FROM problems
+                WHERE status = ? AND next_review IS NOT NULL AND next_review <= ?
+                GROUP BY due_date
+            ''', (today.isoformat(), STATUS_ACTIVE, end_date.isoformat()))
+            counts = {row['due_date']: row['problem_count'] for row in cursor.fetchall()}
+        
+        return [
+            {'date': day, 'due': counts.get(day.isoformat(), 0)}
+            for day in (today + timedelta(days=offset) for offset in range(days))
+        ]
+    
+    def get_status_counts(self) -> Dict[str, int]:
+        """
+        Count problems by status.
+        
+        Returns:
+            dict: Status to number of problems (statuses without problems are omitted)
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT COALESCE(status, ?) AS status, COUNT(*) AS problem_count
+                FROM problems
+                GROUP BY COALESCE(status, ?)
+            ''', (STATUS_ACTIVE, STATUS_ACTIVE))
+            return {row['status']: row['problem_count'] for row in cursor.fetchall()}
+    
+    def get_inbox_problems(self) -> List[Problem]:
+        """
+        Retrieve quickly-captured problems that haven't been triaged yet.
+        
+        Returns:
+            List of inbox Problem instances, oldest first
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM problems WHERE status = ? ORDER BY id', (STATUS_INBOX,))
             return [problem_from_row(row) for row in cursor.fetchall()]
     
     def get_overdue_problems(self) -> List[Problem]:
@@ -137,8 +506,8 @@ class DatabaseManager:
         with self._get_connection() as conn:
             cursor = conn.cursor()
             cursor.execute(
-                'SELECT * FROM problems WHERE next_review < ?',
-                (today.isoformat(),)
+                'SELECT * FROM problems WHERE status = ? AND next_review < ?',
+                (STATUS_ACTIVE, today.isoformat())
             )
             return [problem_from_row(row) for row in cursor.fetchall()]
     
@@ -148,111 +517,2004 @@ class DatabaseManager:
         
         Args:
             problem: Problem instance with updated data
+            
+        Raises:
+            ValidationError: If a changed title, link or difficulty is invalid
         """
+        validate_problem(problem, self.get_problem(problem.id))
         with self._get_connection() as conn:
             cursor = conn.cursor()
-            cursor.execute('''
-                UPDATE problems 
-                SET title = ?, link = ?, approach = ?, code = ?, 
-                    streak_level = ?, next_review = ?, last_marked = ?, history = ?
-                WHERE id = ?
-            ''', (
-                problem.title,
-                problem.link,
-                problem.approach,
-                problem.code,
-                problem.streak_level,
-                problem.next_review.isoformat() if problem.next_review else None,
-                problem.last_marked.isoformat() if problem.last_marked else None,
-                problem.history,
-                problem.id
-            ))
+            self._write_problem(cursor, problem)
             conn.commit()
     
+    def _write_problem(self, cursor: sqlite3.Cursor, problem: Problem) -> None:
+        """Write a problem's fields, keeping its primary solution in sync (no commit)."""
+        cursor.execute('''
+            UPDATE problems 
+            SET title = ?, link = ?, approach = ?, code = ?, tags = ?, difficulty = ?, source = ?, companies = ?,
+                status = ?, streak_level = ?, next_review = ?, last_marked = ?, history = ?, scheduler_state = ?
+            WHERE id = ?
+        ''', (
+            problem.title,
+            problem.link,
+            problem.approach,
+            problem.code,
+            problem.tags,
+            problem.difficulty,
+            problem.source,
+            problem.companies,
+            problem.status,
+            problem.streak_level,
+            problem.next_review.isoformat() if problem.next_review else None,
+            problem.last_marked.isoformat() if problem.last_marked else None,
+            problem.history,
+            problem.scheduler_state,
+            problem.id
+        ))
+        # Keep the primary solution in sync with the problem's own fields
+        cursor.execute('''
+            UPDATE solutions SET approach = ?, code = ?
+            WHERE problem_id = ? AND is_primary = 1
+        ''', (problem.approach, problem.code, problem.id))
+    
+    def save_review_batch(self, reviews: List[Tuple[Problem, str]]) -> None:
+        """
+        Save a batch of graded problems in a single transaction.
+        
+        Either every problem and the daily review counts are updated, or
+        (if anything fails) none of them are.
+        
+        Args:
+            reviews: (problem, grade) pairs, where each problem has already
+                     been marked and grade is 'easy' or 'hard'
+        """
+        today = date.today()
+        with self._transaction() as cursor:
+            for problem, grade in reviews:
+                self._write_problem(cursor, problem)
+                self._add_daily_review(cursor, today, 1, grade)
+    
+    def save_review(self, problem: Problem, grade: str) -> None:
+        """
+        Save a graded problem and count the review in one transaction.
+        
+        Args:
+            problem: Problem that has already been marked
+            grade: 'easy' or 'hard'
+        """
+        self.save_review_batch([(problem, grade)])
+    
+    def merge_problems(self, keep_id: int, duplicate_id: int) -> bool:
+        """
+        Merge a duplicate problem into another one, then delete the duplicate.
+        
+        The kept problem keeps its schedule and gains the duplicate's tags,
+        companies, review history, solutions, journal entries, notes,
+        interview links, collection places and attachments. Its empty fields
+        (link, approach, code, difficulty, source) are filled from the
+        duplicate. The duplicate's primary solution stays primary unless the
+        kept problem already has a primary solution, or an approach or code
+        of its own. FSRS state is rebuilt from the merged history on the
+        next review. Everything happens in one transaction.
+        
+        Args:
+            keep_id: ID of the problem to keep
+            duplicate_id: ID of the problem merged into it and deleted
+            
+        Returns:
+            bool: True if the problems were merged, False if either wasn't found
+                  or both IDs are the same
+        """
+        keep = self.get_problem(keep_id)
+        duplicate = self.get_problem(duplicate_id)
+        if not keep or not duplicate or keep_id == duplicate_id:
+            return False
+        
+        keep_has_own_solution = bool((keep.approach or "").strip() or (keep.code or "").strip())
+        existing_tags = {tag.lower() for tag in keep.tag_list}
+        keep.tags = ", ".join(keep.tag_list + [tag for tag in duplicate.tag_list if tag.lower() not in existing_tags])
+        existing_companies = {company.lower() for company in keep.company_list}
+        keep.companies = ", ".join(keep.company_list + [company for company in duplicate.company_list
+                                                        if company.lower() not in existing_companies])
+        for field in ('link', 'approach', 'code', 'difficulty', 'source'):
+            if not (getattr(keep, field) or "").strip():
+                setattr(keep, field, getattr(duplicate, field))
+        keep.history_list = sorted(keep.history_list + duplicate.history_list, key=lambda entry: entry.get('date', ''))
+        if duplicate.last_marked and (not keep.last_marked or duplicate.last_marked > keep.last_marked):
+            keep.last_marked = duplicate.last_marked
+        # The stored memory state doesn't cover the duplicate's reviews
+        keep.set_scheduler_state('fsrs', {})
+        
+        with self._transaction() as cursor:
+            cursor.execute('SELECT 1 FROM solutions WHERE problem_id = ? AND is_primary = 1', (keep_id,))
+            if cursor.fetchone() or keep_has_own_solution:
+                # The kept problem's own primary solution (or approach and code) stays the primary one
+                cursor.execute('UPDATE solutions SET problem_id = ?, is_primary = 0 WHERE problem_id = ?',
+                               (keep_id, duplicate_id))
+            else:
+                cursor.execute('UPDATE solutions SET problem_id = ? WHERE problem_id = ?', (keep_id, duplicate_id))
+            for table in ('journal_entries', 'notes', 'attachments'):
+                cursor.execute(f'UPDATE {table} SET problem_id = ? WHERE problem_id = ?', (keep_id, duplicate_id))
+            cursor.execute('''
+                INSERT OR IGNORE INTO interview_problems (interview_id, problem_id)
+                SELECT interview_id, ? FROM interview_problems WHERE problem_id = ?
+            ''', (keep_id, duplicate_id))
+            cursor.execute('DELETE FROM interview_problems WHERE problem_id = ?', (duplicate_id,))
+            cursor.execute('''
+                INSERT OR IGNORE INTO collection_problems (collection_id, problem_id, position)
+                SELECT collection_id, ?, position FROM collection_problems WHERE problem_id = ?
+            ''', (keep_id, duplicate_id))
+            cursor.execute('DELETE FROM collection_problems WHERE problem_id = ?', (duplicate_id,))
+            cursor.execute('DELETE FROM problems WHERE id = ?', (duplicate_id,))
+            self._write_problem(cursor, keep)
+        return True
+    
     def delete_problem(self, problem_id: int) -> bool:
         """
         Delete a problem from the database.
         
+        Files attached to the problem are deleted as well.
+        
         Args:
             problem_id: ID of the problem to delete
             
         Returns:
             bool: True if problem was deleted, False if not found
         """
+        attachments = self.get_attachments(problem_id)
+        
         with self._get_connection() as conn:
             cursor = conn.cursor()
-            cursor.execute('DELETE FROM problems WHERE id = ?', (problem_id,))
+            deleted = self._delete_problem_rows(cursor, problem_id)
             conn.commit()
-            return cursor.rowcount > 0
+        
+        for attachment in attachments:
+            self._remove_attachment_file(attachment)
+        return deleted
     
-    def record_daily_review(self, review_date: date = None, count: int = 1) -> None:
+    def _delete_problem_rows(self, cursor: sqlite3.Cursor, problem_id: int) -> bool:
+        """Delete a problem and the rows that belong to it (no commit)."""
+        cursor.execute('DELETE FROM problems WHERE id = ?', (problem_id,))
+        deleted = cursor.rowcount > 0
+        # Keep notes that referenced the problem, just unlink them
+        cursor.execute('UPDATE notes SET problem_id = NULL WHERE problem_id = ?', (problem_id,))
+        cursor.execute('DELETE FROM solutions WHERE problem_id = ?', (problem_id,))
+        cursor.execute('DELETE FROM journal_entries WHERE problem_id = ?', (problem_id,))
+        cursor.execute('DELETE FROM interview_problems WHERE problem_id = ?', (problem_id,))
+        cursor.execute('DELETE FROM collection_problems WHERE problem_id = ?', (problem_id,))
+        cursor.execute('DELETE FROM attachments WHERE problem_id = ?', (problem_id,))
+        return deleted
+    
+    def trash_problem(self, problem_id: int) -> bool:
         """
-        Record that problems were reviewed on a specific date.
+        Move a problem to the trash.
+        
+        The problem and everything that belongs to it (solutions, journal
+        entries, attachments, note, interview and collection links) are saved
+        in the trash and removed from the rest of the app, so they can be
+        restored until the trash is purged. Attached files are kept until then.
         
         Args:
-            review_date: Date of review (defaults to today)
-            count: Number of problems reviewed (defaults to 1)
+            problem_id: ID of the problem to move to the trash
+            
+        Returns:
+            bool: True if the problem was moved, False if not found
         """
-        if review_date is None:
-            review_date = date.today()
+        with self._transaction() as cursor:
+            cursor.execute('SELECT * FROM problems WHERE id = ?', (problem_id,))
+            problem_row = cursor.fetchone()
+            if not problem_row:
+                return False
+            
+            data = {'problem': dict(problem_row)}
+            for table in ('solutions', 'journal_entries', 'attachments'):
+                cursor.execute(f'SELECT * FROM {table} WHERE problem_id = ? ORDER BY id', (problem_id,))
+                data[table] = [dict(row) for row in cursor.fetchall()]
+            cursor.execute('SELECT id FROM notes WHERE problem_id = ?', (problem_id,))
+            data['note_ids'] = [row[0] for row in cursor.fetchall()]
+            cursor.execute('SELECT interview_id FROM interview_problems WHERE problem_id = ?', (problem_id,))
+            data['interview_ids'] = [row[0] for row in cursor.fetchall()]
+            cursor.execute('SELECT collection_id, position FROM collection_problems WHERE problem_id = ?', (problem_id,))
+            data['collections'] = [dict(row) for row in cursor.fetchall()]
+            
+            cursor.execute(
+                'INSERT INTO trash (problem_id, title, deleted_at, data) VALUES (?, ?, ?, ?)',
+                (problem_id, problem_row['title'], date.today().isoformat(), json.dumps(data))
+            )
+            self._delete_problem_rows(cursor, problem_id)
+            return True
+    
+    def get_trash(self) -> List[Dict[str, Any]]:
+        """
+        Retrieve the problems in the trash, most recently deleted first.
         
+        Returns:
+            List of dictionaries with id (of the trash entry), problem_id,
+            title and deleted_at
+        """
         with self._get_connection() as conn:
             cursor = conn.cursor()
-            cursor.execute('''
-                INSERT OR REPLACE INTO streak_tracker (date, problems_reviewed)
-                VALUES (?, COALESCE((SELECT problems_reviewed FROM streak_tracker WHERE date = ?), 0) + ?)
-            ''', (review_date.isoformat(), review_date.isoformat(), count))
-            conn.commit()
+            cursor.execute('SELECT id, problem_id, title, deleted_at FROM trash ORDER BY deleted_at DESC, id DESC')
+            return [
+                {
+                    'id': row['id'],
+                    'problem_id': row['problem_id'],
+                    'title': row['title'],
+                    'deleted_at': date.fromisoformat(row['deleted_at']) if row['deleted_at'] else None
+                }
+                for row in cursor.fetchall()
+            ]
     
-    def get_streak_data(self, days: int = 30) -> List[Dict[str, Any]]:
+    def restore_from_trash(self, trash_id: int) -> Optional[int]:
         """
-        Get streak data for the last N days.
+        Restore a problem from the trash with its original ID.
+        
+        Notes are linked again unless they were linked to another problem
+        in the meantime, and interview and collection links are restored
+        for interviews and collections that still exist.
         
         Args:
-            days: Number of days to retrieve (defaults to 30)
+            trash_id: ID of the trash entry
             
         Returns:
-            List of dictionaries with date and problems_reviewed
+            int: ID of the restored problem, or None if the entry wasn't found
         """
-        end_date = date.today()
-        start_date = end_date - timedelta(days=days - 1)
-        
-        with self._get_connection() as conn:
-            cursor = conn.cursor()
-            cursor.execute('''
-                SELECT date, problems_reviewed 
-                FROM streak_tracker 
-                WHERE date BETWEEN ? AND ?
-                ORDER BY date DESC
-            ''', (start_date.isoformat(), end_date.isoformat()))
+        with self._transaction() as cursor:
+            cursor.execute('SELECT data FROM trash WHERE id = ?', (trash_id,))
+            row = cursor.fetchone()
+            if not row:
+                return None
             
-            return [{'date': row['date'], 'problems_reviewed': row['problems_reviewed']} 
-                    for row in cursor.fetchall()]
+            data = json.loads(row['data'])
+            problem_id = data['problem']['id']
+            self._insert_row(cursor, 'problems', data['problem'])
+            for table in ('solutions', 'journal_entries', 'attachments'):
+                for table_row in data[table]:
+                    self._insert_row(cursor, table, table_row)
+            for note_id in data['note_ids']:
+                cursor.execute('UPDATE notes SET problem_id = ? WHERE id = ? AND problem_id IS NULL',
+                               (problem_id, note_id))
+            for interview_id in data['interview_ids']:
+                cursor.execute('''
+                    INSERT OR IGNORE INTO interview_problems (interview_id, problem_id)
+                    SELECT id, ? FROM interviews WHERE id = ?
+                ''', (problem_id, interview_id))
+            for membership in data.get('collections', []):
+                cursor.execute('''
+                    INSERT OR IGNORE INTO collection_problems (collection_id, problem_id, position)
+                    SELECT id, ?, ? FROM collections WHERE id = ?
+                ''', (problem_id, membership['position'], membership['collection_id']))
+            cursor.execute('DELETE FROM trash WHERE id = ?', (trash_id,))
+            return problem_id
     
-    def get_current_streak(self) -> int:
+    def purge_trash(self, older_than_days: int = TRASH_RETENTION_DAYS) -> int:
         """
-        Calculate the current consecutive streak of days with reviews.
+        Permanently delete problems that have been in the trash too long.
         
+        Their attached files are deleted as well.
+        
+        Args:
+            older_than_days: Purge entries deleted at least this many days ago
+                             (0 empties the whole trash)
+            
         Returns:
-            int: Number of consecutive days with at least one review
+            int: Number of problems purged
         """
-        streak = 0
-        current_date = date.today()
+        cutoff = (date.today() - timedelta(days=older_than_days)).isoformat()
         
         with self._get_connection() as conn:
             cursor = conn.cursor()
+            cursor.execute('SELECT id, data FROM trash WHERE deleted_at <= ?', (cutoff,))
+            rows = cursor.fetchall()
+            cursor.execute('DELETE FROM trash WHERE deleted_at <= ?', (cutoff,))
+            conn.commit()
+        
+        for row in rows:
+            for attachment_row in json.loads(row['data'])['attachments']:
+                self._remove_attachment_file(attachment_from_row(attachment_row))
+        return len(rows)
+    
+    def _insert_row(self, cursor: sqlite3.Cursor, table: str, values: Dict[str, Any]) -> None:
+        """Insert a row saved as a column-to-value dictionary (no commit)."""
+        columns = ', '.join(values)
+        placeholders = ', '.join('?' for _ in values)
+        cursor.execute(f'INSERT INTO {table} ({columns}) VALUES ({placeholders})', tuple(values.values()))
+    
+    def add_solution(self, solution: Solution) -> int:
+        """
+        Add a new solution to a problem.
+        
+        Args:
+            solution: Solution instance to add
             
-            while True:
-                cursor.execute(
-                    'SELECT problems_reviewed FROM streak_tracker WHERE date = ?',
-                    (current_date.isoformat(),)
-                )
-                row = cursor.fetchone()
-                
-                if row and row['problems_reviewed'] > 0:
-                    streak += 1
-                    current_date -= timedelta(days=1)
-                else:
-                    break
-        
-        return streak
+        Returns:
+            int: ID of the newly created solution
+            
+        Raises:
+            ValidationError: If the language isn't supported
+        """
+        validate_solution(solution)
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT INTO solutions (problem_id, language, code, approach, complexity, is_primary,
+                                       streak_level, next_review, last_marked, history, scheduler_state)
+                VALUES (?, ?, ?, ?, ?, 0, ?, ?, ?, ?, ?)
+            ''', (
+                solution.problem_id,
+                solution.language,
+                solution.code,
+                solution.approach,
+                solution.complexity,
+                solution.streak_level,
+                solution.next_review.isoformat() if solution.next_review else None,
+                solution.last_marked.isoformat() if solution.last_marked else None,
+                solution.history,
+                solution.scheduler_state
+            ))
+            conn.commit()
+            solution_id = cursor.lastrowid
+        
+        if solution.is_primary:
+            self.set_primary_solution(solution_id)
+        return solution_id
+    
+    def get_solution(self, solution_id: int) -> Optional[Solution]:
+        """
+        Retrieve a solution by ID.
+        
+        Args:
+            solution_id: ID of the solution to retrieve
+            
+        Returns:
+            Solution instance if found, None otherwise
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM solutions WHERE id = ?', (solution_id,))
+            row = cursor.fetchone()
+            return solution_from_row(row) if row else None
+    
+    def get_solutions(self, problem_id: int) -> List[Solution]:
+        """
+        Retrieve all solutions for a problem, primary first.
+        
+        Args:
+            problem_id: ID of the problem
+            
+        Returns:
+            List of Solution instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'SELECT * FROM solutions WHERE problem_id = ? ORDER BY is_primary DESC, id',
+                (problem_id,)
+            )
+            return [solution_from_row(row) for row in cursor.fetchall()]
+    
+    def iter_solutions(self) -> Iterator[Solution]:
+        """
+        Iterate over every problem's solutions without loading them all into memory.
+        
+        Yields:
+            Solution instances ordered by problem, then ID
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM solutions ORDER BY problem_id, id')
+            for row in cursor:
+                yield solution_from_row(row)
+    
+    def get_due_solutions(self, target_date: date = None) -> List[Solution]:
+        """
+        Retrieve solutions whose language track is due for review.
+        
+        Only tracks of active problems are scheduled.
+        
+        Args:
+            target_date: Date to check for due tracks (defaults to today)
+            
+        Returns:
+            List of Solution instances due for review
+        """
+        if target_date is None:
+            target_date = date.today()
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT solutions.* FROM solutions
+                JOIN problems ON problems.id = solutions.problem_id
+                WHERE problems.status = ? AND solutions.next_review <= ?
+                ORDER BY solutions.next_review, solutions.id
+            ''', (STATUS_ACTIVE, target_date.isoformat()))
+            return [solution_from_row(row) for row in cursor.fetchall()]
+    
+    def update_solution(self, solution: Solution) -> None:
+        """
+        Update an existing solution.
+        
+        If the solution is primary, the problem's approach and code are
+        updated to match.
+        
+        Args:
+            solution: Solution instance with updated data
+        """
+        with self._transaction() as cursor:
+            self._write_solution(cursor, solution)
+    
+    def save_solution_review(self, solution: Solution, grade: str) -> None:
+        """
+        Save a graded language track and count the review in one transaction.
+        
+        Args:
+            solution: Solution that has already been marked
+            grade: 'easy' or 'hard'
+        """
+        with self._transaction() as cursor:
+            self._write_solution(cursor, solution)
+            self._add_daily_review(cursor, date.today(), 1, grade)
+    
+    def _write_solution(self, cursor: sqlite3.Cursor, solution: Solution) -> None:
+        """Write a solution's fields, and a primary one's to its problem (no commit)."""
+        cursor.execute('''
+            UPDATE solutions
+            SET language = ?, code = ?, approach = ?, complexity = ?,
+                streak_level = ?, next_review = ?, last_marked = ?, history = ?, scheduler_state = ?
+            WHERE id = ?
+        ''', (
+            solution.language,
+            solution.code,
+            solution.approach,
+            solution.complexity,
+            solution.streak_level,
+            solution.next_review.isoformat() if solution.next_review else None,
+            solution.last_marked.isoformat() if solution.last_marked else None,
+            solution.history,
+            solution.scheduler_state,
+            solution.id
+        ))
+        cursor.execute('''
+            UPDATE problems SET approach = ?, code = ?
+            WHERE id = (SELECT problem_id FROM solutions WHERE id = ? AND is_primary = 1)
+        ''', (solution.approach, solution.code, solution.id))
+    
+    def set_primary_solution(self, solution_id: int) -> bool:
+        """
+        Make a solution the primary one for its problem.
+        
+        The problem's approach and code are replaced by the solution's.
+        
+        Args:
+            solution_id: ID of the solution to promote
+            
+        Returns:
+            bool: True if the solution was found and promoted
+        """
+        solution = self.get_solution(solution_id)
+        if solution is None:
+            return False
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'UPDATE solutions SET is_primary = (id = ?) WHERE problem_id = ?',
+                (solution_id, solution.problem_id)
+            )
+            cursor.execute(
+                'UPDATE problems SET approach = ?, code = ? WHERE id = ?',
+                (solution.approach, solution.code, solution.problem_id)
+            )
+            conn.commit()
+        return True
+    
+    def delete_solution(self, solution_id: int) -> bool:
+        """
+        Delete a solution.
+        
+        Deleting the primary solution leaves the problem's approach and
+        code untouched.
+        
+        Args:
+            solution_id: ID of the solution to delete
+            
+        Returns:
+            bool: True if solution was deleted, False if not found
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('DELETE FROM solutions WHERE id = ?', (solution_id,))
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def add_journal_entry(self, entry: JournalEntry) -> int:
+        """
+        Add a journal entry to a problem.
+        
+        Args:
+            entry: JournalEntry instance to add (created_at defaults to now)
+            
+        Returns:
+            int: ID of the newly created entry
+        """
+        created_at = entry.created_at or datetime.now()
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'INSERT INTO journal_entries (problem_id, body, created_at) VALUES (?, ?, ?)',
+                (entry.problem_id, entry.body, created_at.strftime(JOURNAL_TIMESTAMP_FORMAT))
+            )
+            conn.commit()
+            return cursor.lastrowid
+    
+    def get_journal_entry(self, entry_id: int) -> Optional[JournalEntry]:
+        """
+        Retrieve a journal entry by ID.
+        
+        Args:
+            entry_id: ID of the entry to retrieve
+            
+        Returns:
+            JournalEntry instance if found, None otherwise
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM journal_entries WHERE id = ?', (entry_id,))
+            row = cursor.fetchone()
+            return journal_entry_from_row(row) if row else None
+    
+    def get_journal_entries(self, problem_id: int) -> List[JournalEntry]:
+        """
+        Retrieve a problem's journal entries, newest first.
+        
+        Args:
+            problem_id: ID of the problem
+            
+        Returns:
+            List of JournalEntry instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'SELECT * FROM journal_entries WHERE problem_id = ? ORDER BY created_at DESC, id DESC',
+                (problem_id,)
+            )
+            return [journal_entry_from_row(row) for row in cursor.fetchall()]
+    
+    def iter_journal_entries(self) -> Iterator[JournalEntry]:
+        """
+        Iterate over every problem's journal entries, oldest first.
+        
+        Yields:
+            JournalEntry instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM journal_entries ORDER BY created_at, id')
+            for row in cursor:
+                yield journal_entry_from_row(row)
+    
+    def update_journal_entry(self, entry: JournalEntry) -> None:
+        """
+        Update the text of a journal entry (its timestamp is kept).
+        
+        Args:
+            entry: JournalEntry instance with updated body
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('UPDATE journal_entries SET body = ? WHERE id = ?', (entry.body, entry.id))
+            conn.commit()
+    
+    def delete_journal_entry(self, entry_id: int) -> bool:
+        """
+        Delete a journal entry.
+        
+        Args:
+            entry_id: ID of the entry to delete
+            
+        Returns:
+            bool: True if the entry was deleted, False if not found
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('DELETE FROM journal_entries WHERE id = ?', (entry_id,))
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def add_note(self, note: Note) -> int:
+        """
+        Add a new note to the database.
+        
+        Args:
+            note: Note instance to add
+            
+        Returns:
+            int: ID of the newly created note
+        """
+        today = date.today()
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT INTO notes (title, body, tags, problem_id, created_at, updated_at)
+                VALUES (?, ?, ?, ?, ?, ?)
+            ''', (
+                note.title,
+                note.body,
+                note.tags,
+                note.problem_id,
+                (note.created_at or today).isoformat(),
+                today.isoformat()
+            ))
+            conn.commit()
+            return cursor.lastrowid
+    
+    def get_note(self, note_id: int) -> Optional[Note]:
+        """
+        Retrieve a note by ID.
+        
+        Args:
+            note_id: ID of the note to retrieve
+            
+        Returns:
+            Note instance if found, None otherwise
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM notes WHERE id = ?', (note_id,))
+            row = cursor.fetchone()
+            return note_from_row(row) if row else None
+    
+    def get_all_notes(self) -> List[Note]:
+        """
+        Retrieve all notes from the database.
+        
+        Returns:
+            List of all Note instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM notes ORDER BY id')
+            return [note_from_row(row) for row in cursor.fetchall()]
+    
+    def search_notes(self, query: str) -> List[Note]:
+        """
+        Find notes whose title, body or tags contain the query.
+        
+        Matching is case-insensitive and Unicode-normalized (see
+        normalize_search_text).
+        
+        Args:
+            query: Text to search for
+            
+        Returns:
+            List of matching Note instances
+        """
+        needle = normalize_search_text(query)
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT * FROM notes
+                WHERE instr(normalize(title), ?) OR instr(normalize(body), ?)
+                   OR instr(normalize(tags), ?)
+                ORDER BY id
+            ''', (needle, needle, needle))
+            return [note_from_row(row) for row in cursor.fetchall()]
+    
+    def iter_notes(self) -> Iterator[Note]:
+        """
+        Iterate over all notes without loading them all into memory.
+        
+        Yields:
+            Note instances ordered by ID
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM notes ORDER BY id')
+            for row in cursor:
+                yield note_from_row(row)
+    
+    def get_notes_for_problem(self, problem_id: int) -> List[Note]:
+        """
+        Retrieve notes linked to a problem.
+        
+        Args:
+            problem_id: ID of the linked problem
+            
+        Returns:
+            List of Note instances linked to the problem
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM notes WHERE problem_id = ? ORDER BY id', (problem_id,))
+            return [note_from_row(row) for row in cursor.fetchall()]
+    
+    def update_note(self, note: Note) -> None:
+        """
+        Update an existing note in the database.
+        
+        Args:
+            note: Note instance with updated data
+        """
+        note.updated_at = date.today()
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                UPDATE notes
+                SET title = ?, body = ?, tags = ?, problem_id = ?, updated_at = ?
+                WHERE id = ?
+            ''', (
+                note.title,
+                note.body,
+                note.tags,
+                note.problem_id,
+                note.updated_at.isoformat(),
+                note.id
+            ))
+            conn.commit()
+    
+    def delete_note(self, note_id: int) -> bool:
+        """
+        Delete a note from the database.
+        
+        Args:
+            note_id: ID of the note to delete
+            
+        Returns:
+            bool: True if note was deleted, False if not found
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('DELETE FROM notes WHERE id = ?', (note_id,))
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def add_interview(self, interview: Interview) -> int:
+        """
+        Add a new interview to the database.
+        
+        Args:
+            interview: Interview instance to add
+            
+        Returns:
+            int: ID of the newly created interview
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT INTO interviews (company, interview_date, round_name, outcome, notes)
+                VALUES (?, ?, ?, ?, ?)
+            ''', (
+                interview.company,
+                (interview.interview_date or date.today()).isoformat(),
+                interview.round_name,
+                interview.outcome,
+                interview.notes
+            ))
+            conn.commit()
+            return cursor.lastrowid
+    
+    def get_interview(self, interview_id: int) -> Optional[Interview]:
+        """
+        Retrieve an interview by ID.
+        
+        Args:
+            interview_id: ID of the interview to retrieve
+            
+        Returns:
+            Interview instance if found, None otherwise
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM interviews WHERE id = ?', (interview_id,))
+            row = cursor.fetchone()
+            return interview_from_row(row) if row else None
+    
+    def get_all_interviews(self) -> List[Interview]:
+        """
+        Retrieve all interviews, most recent first.
+        
+        Returns:
+            List of all Interview instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM interviews ORDER BY interview_date DESC, id DESC')
+            return [interview_from_row(row) for row in cursor.fetchall()]
+    
+    def update_interview(self, interview: Interview) -> None:
+        """
+        Update an existing interview in the database.
+        
+        Args:
+            interview: Interview instance with updated data
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                UPDATE interviews
+                SET company = ?, interview_date = ?, round_name = ?, outcome = ?, notes = ?
+                WHERE id = ?
+            ''', (
+                interview.company,
+                interview.interview_date.isoformat() if interview.interview_date else None,
+                interview.round_name,
+                interview.outcome,
+                interview.notes,
+                interview.id
+            ))
+            conn.commit()
+    
+    def delete_interview(self, interview_id: int) -> bool:
+        """
+        Delete an interview and its links to problems.
+        
+        Args:
+            interview_id: ID of the interview to delete
+            
+        Returns:
+            bool: True if interview was deleted, False if not found
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('DELETE FROM interviews WHERE id = ?', (interview_id,))
+            deleted = cursor.rowcount > 0
+            cursor.execute('DELETE FROM interview_problems WHERE interview_id = ?', (interview_id,))
+            conn.commit()
+            return deleted
+    
+    def link_interview_problem(self, interview_id: int, problem_id: int) -> bool:
+        """
+        Record that a problem came up in an interview.
+        
+        Args:
+            interview_id: ID of the interview
+            problem_id: ID of the problem
+            
+        Returns:
+            bool: True if the link was added, False if it already existed
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'INSERT OR IGNORE INTO interview_problems (interview_id, problem_id) VALUES (?, ?)',
+                (interview_id, problem_id)
+            )
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def unlink_interview_problem(self, interview_id: int, problem_id: int) -> bool:
+        """
+        Remove a problem from an interview.
+        
+        Args:
+            interview_id: ID of the interview
+            problem_id: ID of the problem
+            
+        Returns:
+            bool: True if the link was removed, False if it didn't exist
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'DELETE FROM interview_problems WHERE interview_id = ? AND problem_id = ?',
+                (interview_id, problem_id)
+            )
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def get_interview_problems(self, interview_id: int) -> List[Problem]:
+        """
+        Retrieve the problems that came up in an interview.
+        
+        Args:
+            interview_id: ID of the interview
+            
+        Returns:
+            List of linked Problem instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT problems.* FROM problems
+                JOIN interview_problems ON interview_problems.problem_id = problems.id
+                WHERE interview_problems.interview_id = ?
+                ORDER BY problems.id
+            ''', (interview_id,))
+            return [problem_from_row(row) for row in cursor.fetchall()]
+    
+    def get_interview_statistics(self) -> Dict[str, Any]:
+        """
+        Summarize what actually comes up in recorded interviews.
+        
+        Returns:
+            dict: Statistics with keys:
+                - total_interviews: Number of recorded interviews
+                - by_company: Company to interview count, most common first
+                - by_outcome: Outcome to interview count
+                - by_tag: Tag to the number of times a problem with that tag
+                  was asked, most common first
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            
+            cursor.execute('''
+                SELECT company, COUNT(*) AS interview_count
+                FROM interviews
+                GROUP BY company
+                ORDER BY interview_count DESC, company
+            ''')
+            by_company = {row['company']: row['interview_count'] for row in cursor.fetchall()}
+            
+            cursor.execute('SELECT outcome, COUNT(*) AS interview_count FROM interviews GROUP BY outcome')
+            by_outcome = {row['outcome']: row['interview_count'] for row in cursor.fetchall()}
+            
+            tag_counts = {}
+            cursor.execute('''
+                SELECT problems.tags FROM problems
+                JOIN interview_problems ON interview_problems.problem_id = problems.id
+            ''')
+            for row in cursor:
+                for tag in split_tags(row['tags']):
+                    tag_counts[tag] = tag_counts.get(tag, 0) + 1
+        
+        return {
+            'total_interviews': sum(by_company.values()),
+            'by_company': by_company,
+            'by_outcome': by_outcome,
+            'by_tag': dict(sorted(tag_counts.items(), key=lambda item: (-item[1], item[0].lower()))),
+        }
+    
+    def add_collection(self, collection: Collection) -> int:
+        """
+        Add a new collection to the database.
+        
+        Args:
+            collection: Collection instance to add
+            
+        Returns:
+            int: ID of the newly created collection
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'INSERT INTO collections (name, description, created_at) VALUES (?, ?, ?)',
+                (collection.name, collection.description, (collection.created_at or date.today()).isoformat())
+            )
+            conn.commit()
+            return cursor.lastrowid
+    
+    def get_collection(self, collection_id: int) -> Optional[Collection]:
+        """
+        Retrieve a collection by ID.
+        
+        Args:
+            collection_id: ID of the collection to retrieve
+            
+        Returns:
+            Collection instance or None if not found
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM collections WHERE id = ?', (collection_id,))
+            row = cursor.fetchone()
+            return collection_from_row(row) if row else None
+    
+    def get_all_collections(self) -> List[Collection]:
+        """
+        Retrieve all collections, ordered by name.
+        
+        Returns:
+            List of all Collection instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM collections ORDER BY name COLLATE NOCASE, id')
+            return [collection_from_row(row) for row in cursor.fetchall()]
+    
+    def update_collection(self, collection: Collection) -> None:
+        """
+        Update an existing collection's name and description.
+        
+        Args:
+            collection: Collection instance with updated data
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'UPDATE collections SET name = ?, description = ? WHERE id = ?',
+                (collection.name, collection.description, collection.id)
+            )
+            conn.commit()
+    
+    def delete_collection(self, collection_id: int) -> bool:
+        """
+        Delete a collection. The problems in it are kept.
+        
+        Args:
+            collection_id: ID of the collection to delete
+            
+        Returns:
+            bool: True if collection was deleted, False if not found
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('DELETE FROM collections WHERE id = ?', (collection_id,))
+            deleted = cursor.rowcount > 0
+            cursor.execute('DELETE FROM collection_problems WHERE collection_id = ?', (collection_id,))
+            conn.commit()
+            return deleted
+    
+    def add_problem_to_collection(self, collection_id: int, problem_id: int) -> bool:
+        """
+        Add a problem to the end of a collection.
+        
+        Args:
+            collection_id: ID of the collection
+            problem_id: ID of the problem
+            
+        Returns:
+            bool: True if the problem was added, False if it was already in the collection
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT OR IGNORE INTO collection_problems (collection_id, problem_id, position)
+                SELECT ?, ?, COALESCE(MAX(position), 0) + 1 FROM collection_problems WHERE collection_id = ?
+            ''', (collection_id, problem_id, collection_id))
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def remove_problem_from_collection(self, collection_id: int, problem_id: int) -> bool:
+        """
+        Remove a problem from a collection.
+        
+        Args:
+            collection_id: ID of the collection
+            problem_id: ID of the problem
+            
+        Returns:
+            bool: True if the problem was removed, False if it wasn't in the collection
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'DELETE FROM collection_problems WHERE collection_id = ? AND problem_id = ?',
+                (collection_id, problem_id)
+            )
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def move_collection_problem(self, collection_id: int, problem_id: int, position: int) -> bool:
+        """
+        Move a problem to another place in a collection.
+        
+        Args:
+            collection_id: ID of the collection
+            problem_id: ID of the problem to move
+            position: New 1-based position (clamped to the collection's size)
+            
+        Returns:
+            bool: True if the problem was moved, False if it isn't in the collection
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'SELECT problem_id FROM collection_problems WHERE collection_id = ? ORDER BY position, problem_id',
+                (collection_id,)
+            )
+            order = [row[0] for row in cursor.fetchall()]
+            if problem_id not in order:
+                return False
+            
+            order.remove(problem_id)
+            order.insert(max(0, min(position - 1, len(order))), problem_id)
+            cursor.executemany(
+                'UPDATE collection_problems SET position = ? WHERE collection_id = ? AND problem_id = ?',
+                [(index, collection_id, member_id) for index, member_id in enumerate(order, 1)]
+            )
+            conn.commit()
+            return True
+    
+    def get_collection_problems(self, collection_id: int) -> List[Problem]:
+        """
+        Retrieve the problems in a collection, in the collection's order.
+        
+        Args:
+            collection_id: ID of the collection
+            
+        Returns:
+            List of Problem instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT problems.* FROM problems
+                JOIN collection_problems ON collection_problems.problem_id = problems.id
+                WHERE collection_problems.collection_id = ?
+                ORDER BY collection_problems.position, problems.id
+            ''', (collection_id,))
+            return [problem_from_row(row) for row in cursor.fetchall()]
+    
+    def get_problem_collections(self, problem_id: int) -> List[Collection]:
+        """
+        Retrieve the collections a problem is in.
+        
+        Args:
+            problem_id: ID of the problem
+            
+        Returns:
+            List of Collection instances, ordered by name
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT collections.* FROM collections
+                JOIN collection_problems ON collection_problems.collection_id = collections.id
+                WHERE collection_problems.problem_id = ?
+                ORDER BY collections.name COLLATE NOCASE, collections.id
+            ''', (problem_id,))
+            return [collection_from_row(row) for row in cursor.fetchall()]
+    
+    def add_focus_session(self, session: FocusSession) -> int:
+        """
+        Store a finished focus session.
+        
+        Args:
+            session: FocusSession instance to add
+            
+        Returns:
+            int: ID of the newly created session
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT INTO focus_sessions
+                    (started_at, ended_at, duration_minutes, tag, difficulty, easy_count, hard_count)
+                VALUES (?, ?, ?, ?, ?, ?, ?)
+            ''', (
+                session.started_at.strftime(JOURNAL_TIMESTAMP_FORMAT) if session.started_at else None,
+                session.ended_at.strftime(JOURNAL_TIMESTAMP_FORMAT) if session.ended_at else None,
+                session.duration_minutes,
+                session.tag,
+                session.difficulty,
+                session.easy_count,
+                session.hard_count
+            ))
+            conn.commit()
+            return cursor.lastrowid
+    
+    def get_focus_sessions(self, limit: int = 10) -> List[FocusSession]:
+        """
+        Retrieve the most recent focus sessions.
+        
+        Args:
+            limit: Maximum number of sessions to return
+            
+        Returns:
+            List of FocusSession instances, newest first
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM focus_sessions ORDER BY started_at DESC, id DESC LIMIT ?', (limit,))
+            return [focus_session_from_row(row) for row in cursor.fetchall()]
+    
+    def add_attachment(self, attachment: Attachment) -> int:
+        """
+        Record a file that was copied into the attachments directory.
+        
+        Args:
+            attachment: Attachment instance to add
+            
+        Returns:
+            int: ID of the newly created attachment
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT INTO attachments (problem_id, filename, stored_name, mime_type, size_bytes, created_at)
+                VALUES (?, ?, ?, ?, ?, ?)
+            ''', (
+                attachment.problem_id,
+                attachment.filename,
+                attachment.stored_name,
+                attachment.mime_type,
+                attachment.size_bytes,
+                (attachment.created_at or date.today()).isoformat()
+            ))
+            conn.commit()
+            return cursor.lastrowid
+    
+    def get_attachment(self, attachment_id: int) -> Optional[Attachment]:
+        """
+        Retrieve an attachment by ID.
+        
+        Args:
+            attachment_id: ID of the attachment to retrieve
+            
+        Returns:
+            Attachment instance if found, None otherwise
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM attachments WHERE id = ?', (attachment_id,))
+            row = cursor.fetchone()
+            return attachment_from_row(row) if row else None
+    
+    def get_attachments(self, problem_id: int) -> List[Attachment]:
+        """
+        Retrieve the files attached to a problem, oldest first.
+        
+        Args:
+            problem_id: ID of the problem
+            
+        Returns:
+            List of Attachment instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM attachments WHERE problem_id = ? ORDER BY id', (problem_id,))
+            return [attachment_from_row(row) for row in cursor.fetchall()]
+    
+    def delete_attachment(self, attachment_id: int) -> bool:
+        """
+        Delete an attachment and its stored file.
+        
+        Args:
+            attachment_id: ID of the attachment to delete
+            
+        Returns:
+            bool: True if the attachment was deleted, False if not found
+        """
+        attachment = self.get_attachment(attachment_id)
+        if not attachment:
+            return False
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('DELETE FROM attachments WHERE id = ?', (attachment_id,))
+            conn.commit()
+        
+        self._remove_attachment_file(attachment)
+        return True
+    
+    def _remove_attachment_file(self, attachment: Attachment) -> None:
+        """
+        Delete an attachment's stored file, ignoring files that are already gone.
+        
+        Args:
+            attachment: Attachment whose file should be removed
+        """
+        get_storage(ATTACHMENTS_AREA).delete(attachment.stored_name)
+    
+    def add_notification(self, kind: str, message: str) -> int:
+        """
+        Add a new unread notification.
+        
+        Args:
+            kind: Event that caused it (one of the NOTIFY_* constants in config)
+            message: Text shown to the user
+            
+        Returns:
+            int: ID of the newly created notification
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'INSERT INTO notifications (kind, message, created_at, is_read) VALUES (?, ?, ?, 0)',
+                (kind, message, date.today().isoformat())
+            )
+            conn.commit()
+            return cursor.lastrowid
+    
+    def get_notifications(self, unread_only: bool = False) -> List[Notification]:
+        """
+        Retrieve notifications, newest first.
+        
+        Args:
+            unread_only: Only return notifications that haven't been read
+            
+        Returns:
+            List of Notification instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            if unread_only:
+                cursor.execute('SELECT * FROM notifications WHERE is_read = 0 ORDER BY id DESC')
+            else:
+                cursor.execute('SELECT * FROM notifications ORDER BY id DESC')
+            return [notification_from_row(row) for row in cursor.fetchall()]
+    
+    def count_unread_notifications(self) -> int:
+        """
+        Count notifications that haven't been read.
+        
+        Returns:
+            int: Number of unread notifications
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT COUNT(*) FROM notifications WHERE is_read = 0')
+            return cursor.fetchone()[0]
+    
+    def mark_notification_read(self, notification_id: int = None) -> int:
+        """
+        Mark one notification, or all of them, as read.
+        
+        Args:
+            notification_id: ID of the notification (None marks all as read)
+            
+        Returns:
+            int: Number of notifications that were marked
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            if notification_id is None:
+                cursor.execute('UPDATE notifications SET is_read = 1 WHERE is_read = 0')
+            else:
+                cursor.execute(
+                    'UPDATE notifications SET is_read = 1 WHERE id = ? AND is_read = 0',
+                    (notification_id,)
+                )
+            conn.commit()
+            return cursor.rowcount
+    
+    def add_webhook(self, webhook: Webhook) -> int:
+        """
+        Add a new outgoing webhook.
+        
+        Args:
+            webhook: Webhook instance to add
+            
+        Returns:
+            int: ID of the newly created webhook
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT INTO webhooks (url, secret, events, is_active, created_at, channel, target)
+                VALUES (?, ?, ?, ?, ?, ?, ?)
+            ''', (
+                webhook.url,
+                webhook.secret,
+                webhook.events,
+                int(webhook.is_active),
+                (webhook.created_at or date.today()).isoformat(),
+                webhook.channel,
+                webhook.target
+            ))
+            conn.commit()
+            return cursor.lastrowid
+    
+    def get_webhook(self, webhook_id: int) -> Optional[Webhook]:
+        """
+        Retrieve a webhook by ID.
+        
+        Args:
+            webhook_id: ID of the webhook to retrieve
+            
+        Returns:
+            Webhook instance or None if not found
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM webhooks WHERE id = ?', (webhook_id,))
+            row = cursor.fetchone()
+            return webhook_from_row(row) if row else None
+    
+    def get_all_webhooks(self, event: str = None) -> List[Webhook]:
+        """
+        Retrieve webhooks in the order they were added.
+        
+        Args:
+            event: Only return active webhooks subscribed to this event (None for all)
+            
+        Returns:
+            List of Webhook instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM webhooks ORDER BY id')
+            webhooks = [webhook_from_row(row) for row in cursor.fetchall()]
+        
+        if event is None:
+            return webhooks
+        return [webhook for webhook in webhooks if webhook.is_active and event in webhook.event_list]
+    
+    def update_webhook(self, webhook: Webhook) -> None:
+        """
+        Update an existing webhook.
+        
+        Args:
+            webhook: Webhook instance with updated data
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                UPDATE webhooks SET url = ?, secret = ?, events = ?, is_active = ?, channel = ?, target = ?
+                WHERE id = ?
+            ''', (
+                webhook.url,
+                webhook.secret,
+                webhook.events,
+                int(webhook.is_active),
+                webhook.channel,
+                webhook.target,
+                webhook.id
+            ))
+            conn.commit()
+    
+    def delete_webhook(self, webhook_id: int) -> bool:
+        """
+        Delete a webhook and its delivery log.
+        
+        Args:
+            webhook_id: ID of the webhook to delete
+            
+        Returns:
+            bool: True if webhook was deleted, False if not found
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('DELETE FROM webhooks WHERE id = ?', (webhook_id,))
+            deleted = cursor.rowcount > 0
+            cursor.execute('DELETE FROM webhook_deliveries WHERE webhook_id = ?', (webhook_id,))
+            conn.commit()
+            return deleted
+    
+    def add_webhook_delivery(self, delivery: WebhookDelivery) -> int:
+        """
+        Queue an event for delivery to a webhook.
+        
+        Args:
+            delivery: WebhookDelivery instance to add
+            
+        Returns:
+            int: ID of the newly created delivery
+        """
+        created_at = delivery.created_at or datetime.now()
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT INTO webhook_deliveries
+                    (webhook_id, event, payload, status, attempts, response_code, last_error,
+                     created_at, next_attempt_at)
+                VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+            ''', (
+                delivery.webhook_id,
+                delivery.event,
+                delivery.payload,
+                delivery.status,
+                delivery.attempts,
+                delivery.response_code,
+                delivery.last_error,
+                created_at.isoformat(timespec='seconds'),
+                (delivery.next_attempt_at or created_at).isoformat(timespec='seconds')
+            ))
+            conn.commit()
+            return cursor.lastrowid
+    
+    def update_webhook_delivery(self, delivery: WebhookDelivery) -> None:
+        """
+        Store the result of a delivery attempt.
+        
+        Args:
+            delivery: WebhookDelivery instance with updated status
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                UPDATE webhook_deliveries
+                SET status = ?, attempts = ?, response_code = ?, last_error = ?, next_attempt_at = ?
+                WHERE id = ?
+            ''', (
+                delivery.status,
+                delivery.attempts,
+                delivery.response_code,
+                delivery.last_error,
+                delivery.next_attempt_at.isoformat(timespec='seconds') if delivery.next_attempt_at else None,
+                delivery.id
+            ))
+            conn.commit()
+    
+    def get_pending_webhook_deliveries(self, now: datetime = None) -> List[WebhookDelivery]:
+        """
+        Retrieve pending deliveries whose next attempt is due, oldest first.
+        
+        Args:
+            now: Current time (defaults to now)
+            
+        Returns:
+            List of WebhookDelivery instances
+        """
+        now = now or datetime.now()
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                "SELECT * FROM webhook_deliveries WHERE status = 'pending' AND next_attempt_at <= ? ORDER BY id",
+                (now.isoformat(timespec='seconds'),)
+            )
+            return [webhook_delivery_from_row(row) for row in cursor.fetchall()]
+    
+    def get_webhook_deliveries(self, webhook_id: int = None, limit: int = 20) -> List[WebhookDelivery]:
+        """
+        Retrieve the delivery log, newest first.
+        
+        Args:
+            webhook_id: Only return deliveries to this webhook (None for all)
+            limit: Maximum number of deliveries to return
+            
+        Returns:
+            List of WebhookDelivery instances
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            if webhook_id is None:
+                cursor.execute('SELECT * FROM webhook_deliveries ORDER BY id DESC LIMIT ?', (limit,))
+            else:
+                cursor.execute(
+                    'SELECT * FROM webhook_deliveries WHERE webhook_id = ? ORDER BY id DESC LIMIT ?',
+                    (webhook_id, limit)
+                )
+            return [webhook_delivery_from_row(row) for row in cursor.fetchall()]
+    
+    def has_webhook_event_since(self, event: str, day: date) -> bool:
+        """
+        Check whether an event was queued for any webhook on or after a day.
+        
+        Args:
+            event: Event name
+            day: First date to check
+            
+        Returns:
+            bool: True if a delivery for the event was created since that day
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'SELECT 1 FROM webhook_deliveries WHERE event = ? AND created_at >= ? LIMIT 1',
+                (event, day.isoformat())
+            )
+            return cursor.fetchone() is not None
+    
+    def get_settings(self) -> Dict[str, Any]:
+        """
+        Retrieve all user settings, falling back to defaults.
+        
+        Stored values are converted to the type of their default, and
+        values that can't be converted are ignored.
+        
+        Returns:
+            dict: Setting name to value for every key in DEFAULT_SETTINGS
+        """
+        settings = dict(DEFAULT_SETTINGS)
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT key, value FROM settings')
+            for row in cursor.fetchall():
+                key = row['key']
+                if key not in DEFAULT_SETTINGS:
+                    continue
+                try:
+                    settings[key] = type(DEFAULT_SETTINGS[key])(row['value'])
+                except (TypeError, ValueError):
+                    pass
+        
+        return settings
+    
+    def set_setting(self, key: str, value: Any) -> None:
+        """
+        Store a user setting.
+        
+        Args:
+            key: Setting name (must be a key of DEFAULT_SETTINGS)
+            value: New value for the setting
+            
+        Raises:
+            NotFoundError: If the setting name is unknown
+        """
+        if key not in DEFAULT_SETTINGS:
+            raise NotFoundError(f"Unknown setting '{key}'")
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute(
+                'INSERT OR REPLACE INTO settings (key, value) VALUES (?, ?)',
+                (key, str(value))
+            )
+            conn.commit()
+    
+    def record_daily_review(self, review_date: date = None, count: int = 1, grade: str = None) -> None:
+        """
+        Record that problems were reviewed on a specific date.
+        
+        Args:
+            review_date: Date of review (defaults to today)
+            count: Number of problems reviewed (defaults to 1)
+            grade: Review grade ('easy' or 'hard'), used for the pass/fail breakdown
+        """
+        if review_date is None:
+            review_date = date.today()
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            self._add_daily_review(cursor, review_date, count, grade)
+            conn.commit()
+    
+    def _add_daily_review(self, cursor: sqlite3.Cursor, review_date: date, count: int, grade: str) -> None:
+        """Add reviews to a day's streak counts (no commit)."""
+        easy_count = count if grade == 'easy' else 0
+        hard_count = count if grade == 'hard' else 0
+        
+        cursor.execute('''
+            INSERT OR REPLACE INTO streak_tracker (date, problems_reviewed, easy_reviewed, hard_reviewed)
+            VALUES (
+                ?,
+                COALESCE((SELECT problems_reviewed FROM streak_tracker WHERE date = ?), 0) + ?,
+                COALESCE((SELECT easy_reviewed FROM streak_tracker WHERE date = ?), 0) + ?,
+                COALESCE((SELECT hard_reviewed FROM streak_tracker WHERE date = ?), 0) + ?
+            )
+        ''', (
+            review_date.isoformat(),
+            review_date.isoformat(), count,
+            review_date.isoformat(), easy_count,
+            review_date.isoformat(), hard_count
+        ))
+    
+    def get_streak_data(self, days: int = 30) -> List[Dict[str, Any]]:
+        """
+        Get streak data for the last N days.
+        
+        Args:
+            days: Number of days to retrieve (defaults to 30)
+            
+        Returns:
+            List of dictionaries with date, problems_reviewed and the
+            easy/hard breakdown of those reviews
+        """
+        end_date = date.today()
+        start_date = end_date - timedelta(days=days - 1)
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT date, problems_reviewed, easy_reviewed, hard_reviewed
+                FROM streak_tracker 
+                WHERE date BETWEEN ? AND ?
+                ORDER BY date DESC
+            ''', (start_date.isoformat(), end_date.isoformat()))
+            
+            return [{'date': row['date'],
+                     'problems_reviewed': row['problems_reviewed'],
+                     'easy_reviewed': row['easy_reviewed'] or 0,
+                     'hard_reviewed': row['hard_reviewed'] or 0}
+                    for row in cursor.fetchall()]
+    
+    def get_activity_range(self, start_date: date, end_date: date) -> List[Dict[str, Any]]:
+        """
+        Get the daily review log for a date range, including days without reviews.
+        
+        Args:
+            start_date: First day of the range (inclusive)
+            end_date: Last day of the range (inclusive)
+            
+        Returns:
+            List of dictionaries with date (as a date), problems_reviewed and
+            the easy/hard breakdown, one per day in ascending order
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT date, problems_reviewed, easy_reviewed, hard_reviewed
+                FROM streak_tracker
+                WHERE date BETWEEN ? AND ?
+            ''', (start_date.isoformat(), end_date.isoformat()))
+            recorded = {row['date']: row for row in cursor.fetchall()}
+        
+        activity = []
+        current_date = start_date
+        while current_date <= end_date:
+            row = recorded.get(current_date.isoformat())
+            activity.append({
+                'date': current_date,
+                'problems_reviewed': row['problems_reviewed'] if row else 0,
+                'easy_reviewed': (row['easy_reviewed'] or 0) if row else 0,
+                'hard_reviewed': (row['hard_reviewed'] or 0) if row else 0,
+            })
+            current_date += timedelta(days=1)
+        return activity
+    
+    def iter_daily_activity(self) -> Iterator[Dict[str, Any]]:
+        """
+        Iterate over the full daily review log, oldest day first.
+        
+        Yields:
+            Dictionaries with date, problems_reviewed and the easy/hard breakdown
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT date, problems_reviewed, easy_reviewed, hard_reviewed
+                FROM streak_tracker
+                ORDER BY date
+            ''')
+            for row in cursor:
+                yield {'date': row['date'],
+                       'problems_reviewed': row['problems_reviewed'],
+                       'easy_reviewed': row['easy_reviewed'] or 0,
+                       'hard_reviewed': row['hard_reviewed'] or 0}
+    
+    def get_last_review_date(self) -> Optional[date]:
+        """
+        Find the most recent day with at least one review.
+        
+        Returns:
+            date: Last review day, or None if nothing was reviewed yet
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT MAX(date) FROM streak_tracker WHERE problems_reviewed > 0')
+            last_date = cursor.fetchone()[0]
+            return date.fromisoformat(last_date) if last_date else None
+    
+    def get_streak_freezes(self) -> List[Dict[str, Any]]:
+        """
+        Retrieve every streak freeze, oldest first.
+        
+        Returns:
+            List of dictionaries with id, earned_on and used_on (None while
+            the freeze is unused) as dates
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT id, earned_on, used_on FROM streak_freezes ORDER BY earned_on, id')
+            return [{'id': row['id'],
+                     'earned_on': date.fromisoformat(row['earned_on']),
+                     'used_on': date.fromisoformat(row['used_on']) if row['used_on'] else None}
+                    for row in cursor.fetchall()]
+    
+    def add_streak_freeze(self, earned_on: date) -> bool:
+        """
+        Store a newly earned streak freeze.
+        
+        A day earns at most one freeze, so calling this again for the
+        same day does nothing.
+        
+        Args:
+            earned_on: Day the freeze was earned
+            
+        Returns:
+            bool: True if a freeze was added
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('INSERT OR IGNORE INTO streak_freezes (earned_on) VALUES (?)', (earned_on.isoformat(),))
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def use_streak_freezes(self, days: List[date]) -> None:
+        """
+        Spend unused streak freezes, oldest first, on missed days.
+        
+        Args:
+            days: Missed days to cover, one freeze each
+            
+        Raises:
+            ValueError: If there are fewer unused freezes than days
+        """
+        with self._transaction() as cursor:
+            cursor.execute('SELECT id FROM streak_freezes WHERE used_on IS NULL ORDER BY earned_on, id')
+            freeze_ids = [row['id'] for row in cursor.fetchall()]
+            if len(freeze_ids) < len(days):
+                raise ValueError(f"Only {len(freeze_ids)} streak freeze(s) left for {len(days)} missed day(s)")
+            
+            for freeze_id, day in zip(freeze_ids, days):
+                cursor.execute('UPDATE streak_freezes SET used_on = ? WHERE id = ?', (day.isoformat(), freeze_id))
+    
+    def get_streak_badges(self) -> Dict[int, date]:
+        """
+        Retrieve the streak milestones reached so far.
+        
+        Returns:
+            dict: Milestone length in days to the day it was first reached
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT days, earned_on FROM streak_badges ORDER BY days')
+            return {row['days']: date.fromisoformat(row['earned_on']) for row in cursor.fetchall()}
+    
+    def add_streak_badge(self, days: int, earned_on: date) -> bool:
+        """
+        Record that a streak milestone was reached.
+        
+        Args:
+            days: Milestone length in days (one of STREAK_MILESTONES)
+            earned_on: Day it was reached
+            
+        Returns:
+            bool: True if the badge is new, False if it was already earned
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('INSERT OR IGNORE INTO streak_badges (days, earned_on) VALUES (?, ?)',
+                           (days, earned_on.isoformat()))
+            conn.commit()
+            return cursor.rowcount > 0
+    
+    def get_statistics(self) -> Dict[str, Any]:
+        """
+        Compute aggregate statistics about problems and reviews.
+        
+        Returns:
+            dict: Statistics with keys:
+                - total_problems: Number of stored problems
+                - reviews_last_7_days / reviews_last_30_days: Review counts
+                - retention_rate: Share of reviews marked Easy (0-1), or None
+                  if no graded reviews were recorded
+                - average_interval_days: Average gap between the last review and
+                  the next one for reviewed problems, or None
+                - by_difficulty: Difficulty ('' for unset) to problem count
+                - by_tag: Tag to problem count, most common first
+                - by_source: Source ('' for unknown) to problem count, most common first
+                - by_company: Company to problem count, most common first
+                - current_streak / longest_streak: Streaks in days
+        """
+        today = date.today()
+        streaks = StreakService(self)
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            
+            cursor.execute('SELECT COUNT(*) FROM problems')
+            total_problems = cursor.fetchone()[0]
+            
+            cursor.execute('''
+                SELECT COALESCE(SUM(CASE WHEN date > ? THEN problems_reviewed ELSE 0 END), 0),
+                       COALESCE(SUM(CASE WHEN date > ? THEN problems_reviewed ELSE 0 END), 0),
+                       COALESCE(SUM(easy_reviewed), 0),
+                       COALESCE(SUM(hard_reviewed), 0)
+                FROM streak_tracker
+            ''', ((today - timedelta(days=7)).isoformat(), (today - timedelta(days=30)).isoformat()))
+            reviews_7, reviews_30, easy_total, hard_total = cursor.fetchone()
+            
+            cursor.execute('''
+                SELECT AVG(julianday(next_review) - julianday(last_marked))
+                FROM problems
+                WHERE status = ? AND last_marked IS NOT NULL AND next_review IS NOT NULL
+            ''', (STATUS_ACTIVE,))
+            average_interval = cursor.fetchone()[0]
+            
+            cursor.execute('''
+                SELECT COALESCE(difficulty, '') AS difficulty, COUNT(*) AS problem_count
+                FROM problems
+                GROUP BY COALESCE(difficulty, '')
+            ''')
+            by_difficulty = {row['difficulty']: row['problem_count'] for row in cursor.fetchall()}
+            
+            # Tags are stored as comma-separated text, so they're counted here
+            tag_counts = {}
+            cursor.execute("SELECT tags FROM problems WHERE tags IS NOT NULL AND tags != ''")
+            for row in cursor:
+                for tag in split_tags(row['tags']):
+                    tag_counts[tag] = tag_counts.get(tag, 0) + 1
+            
+            cursor.execute('''
+                SELECT COALESCE(source, '') AS source, COUNT(*) AS problem_count
+                FROM problems
+                GROUP BY COALESCE(source, '')
+                ORDER BY problem_count DESC, source
+            ''')
+            by_source = {row['source']: row['problem_count'] for row in cursor.fetchall()}
+            
+            company_counts = {}
+            cursor.execute("SELECT companies FROM problems WHERE companies IS NOT NULL AND companies != ''")
+            for row in cursor:
+                for company in split_tags(row['companies']):
+                    company_counts[company] = company_counts.get(company, 0) + 1
+        
+        graded_total = easy_total + hard_total
+        return {
+            'total_problems': total_problems,
+            'reviews_last_7_days': reviews_7,
+            'reviews_last_30_days': reviews_30,
+            'retention_rate': easy_total / graded_total if graded_total else None,
+            'average_interval_days': average_interval,
+            'by_difficulty': by_difficulty,
+            'by_tag': dict(sorted(tag_counts.items(), key=lambda item: (-item[1], item[0].lower()))),
+            'by_source': by_source,
+            'by_company': dict(sorted(company_counts.items(), key=lambda item: (-item[1], item[0].lower()))),
+            'current_streak': streaks.current_streak(),
+            'longest_streak': streaks.longest_streak(),
+        }
+    
+    def iter_problem_rows(self) -> Iterator[Dict[str, Any]]:
+        """
+        Iterate over raw problem rows without converting them to Problems.
+        
+        Used by integrity checks, which must cope with values that
+        problem_from_row can't parse (e.g. malformed dates).
+        
+        Yields:
+            Dictionaries of column name to stored value, ordered by ID
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('SELECT * FROM problems ORDER BY id')
+            for row in cursor:
+                yield dict(row)
+    
+    def set_problem_field(self, problem_id: int, field: str, value: Any) -> None:
+        """
+        Overwrite a single stored field of a problem.
+        
+        Only used to repair values that can't be loaded as a Problem.
+        
+        Args:
+            problem_id: ID of the problem to repair
+            field: Column to overwrite (next_review, last_marked, history or status)
+            value: New value to store
+            
+        Raises:
+            ValidationError: If the field can't be repaired this way
+        """
+        if field not in ('next_review', 'last_marked', 'history', 'status'):
+            raise ValidationError(f"Field '{field}' cannot be repaired")
+        
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            # The column name is checked against the whitelist above
+            cursor.execute(f'UPDATE problems SET {field} = ? WHERE id = ?', (value, problem_id))
+            conn.commit()
+    
+    def get_orphaned_solution_ids(self) -> List[int]:
+        """
+        Find solutions whose problem no longer exists.
+        
+        Returns:
+            List of solution IDs
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT id FROM solutions
+                WHERE problem_id NOT IN (SELECT id FROM problems)
+                ORDER BY id
+            ''')
+            return [row['id'] for row in cursor.fetchall()]
+    
+    def get_orphaned_note_ids(self) -> List[int]:
+        """
+        Find notes linked to a problem that no longer exists.
+        
+        Returns:
+            List of note IDs
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                SELECT id FROM notes
+                WHERE problem_id IS NOT NULL AND problem_id NOT IN (SELECT id FROM problems)
+                ORDER BY id
+            ''')
+            return [row['id'] for row in cursor.fetchall()]
+    
+    def set_daily_review_counts(self, review_date: date, problems_reviewed: int,
+                                easy_reviewed: int, hard_reviewed: int) -> None:
+        """
+        Overwrite the review counts recorded for a day.
+        
+        Args:
+            review_date: Day to update
+            problems_reviewed: Total number of reviews that day
+            easy_reviewed: Number of reviews marked Easy
+            hard_reviewed: Number of reviews marked Hard
+        """
+        with self._get_connection() as conn:
+            cursor = conn.cursor()
+            cursor.execute('''
+                INSERT OR REPLACE INTO streak_tracker (date, problems_reviewed, easy_reviewed, hard_reviewed)
+                VALUES (?, ?, ?, ?)
+            ''', (review_date.isoformat(), problems_reviewed, easy_reviewed, hard_reviewed))
+            conn.commit()
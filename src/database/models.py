@@ -1,42 +1,109 @@
 """
 Data models for the DSA Recall application.
 
-This module defines the Problem data model and provides
-database schema creation functionality.
+This module defines the Problem, Solution, JournalEntry, Note, Notification,
+Interview and FocusSession data models and provides database schema creation
+functionality.
 """
 
 import json
 import sqlite3
+import unicodedata
 from datetime import date, datetime
-from typing import List, Dict, Any, Optional
+from typing import List, Dict, Any, Optional, Set
 from dataclasses import dataclass
 
+from src.config import DIFFICULTY_LEVELS, STATUS_ACTIVE, JOURNAL_TIMESTAMP_FORMAT, WEEKDAY_NAMES
 
-@dataclass
-class Problem:
+
+def split_tags(tags: str) -> List[str]:
     """
-    Represents a DSA problem with spaced repetition metadata.
+    Split a comma-separated tag string into a list.
     
-    Attributes:
-        id: Unique identifier (auto-generated)
-        title: Problem title
-        link: URL or reference link to the problem
-        approach: Detailed explanation of the solution approach
-        code: Code implementation
-        streak_level: Current streak level for spaced repetition
-        next_review: Date when the problem should be reviewed next
-        last_marked: Date when the problem was last reviewed (None if never)
-        history: JSON string containing review history
+    Args:
+        tags: Comma-separated tags
+        
+    Returns:
+        List of non-empty, stripped tag names
+    """
+    return [tag.strip() for tag in (tags or "").split(',') if tag.strip()]
+
+
+def holiday_weekdays(holidays: str) -> Set[int]:
+    """
+    Convert the holidays setting to weekday indexes.
+    
+    Args:
+        holidays: Comma-separated weekday names (e.g. 'Saturday,Sunday')
+        
+    Returns:
+        Set of weekday indexes (Monday = 0). Unknown names are ignored, and
+        a value naming every day is treated as no holidays at all
+    """
+    names = [name.lower() for name in WEEKDAY_NAMES]
+    weekdays = {names.index(day.lower()) for day in split_tags(holidays) if day.lower() in names}
+    return weekdays if len(weekdays) < len(WEEKDAY_NAMES) else set()
+
+
+def normalize_link(link: str) -> str:
+    """
+    Normalize a link for duplicate checks.
+    
+    Surrounding whitespace and a trailing slash are ignored, so
+    '.../two-sum' and '.../two-sum/' are treated as the same link.
+    
+    Args:
+        link: Link to normalize (may be None)
+        
+    Returns:
+        str: Normalized link ('' if there is none)
+    """
+    return (link or "").strip().rstrip('/')
+
+
+def normalize_search_text(text: str) -> str:
+    """
+    Normalize text so searches ignore case and Unicode representation.
+    
+    Applies NFKC normalization (so e.g. full-width letters and ligatures
+    match their plain forms) followed by case folding.
+    
+    Args:
+        text: Text to normalize (None is treated as empty)
+        
+    Returns:
+        str: Normalized text
+    """
+    return unicodedata.normalize("NFKC", text or "").casefold()
+
+
+def normalize_difficulty(value: str) -> str:
+    """
+    Normalize a difficulty name to one of the known levels.
+    
+    Args:
+        value: Difficulty as entered (case-insensitive, e.g. 'hard' or 'H')
+        
+    Returns:
+        str: Matching level from DIFFICULTY_LEVELS, or '' if unrecognised
+    """
+    value = (value or "").strip().lower()
+    if not value:
+        return ""
+    for level in DIFFICULTY_LEVELS:
+        if level.lower() == value or level[0].lower() == value:
+            return level
+    return ""
+
+
+class ReviewHistoryMixin:
+    """
+    Review history stored as a JSON string in a `history` attribute, and
+    each scheduler's own state as a JSON object in `scheduler_state`.
+    
+    Shared by everything that is scheduled for review (problems, and
+    solutions with their own language track).
     """
-    id: Optional[int] = None
-    title: str = ""
-    link: str = ""
-    approach: str = ""
-    code: str = ""
-    streak_level: int = 1
-    next_review: Optional[date] = None
-    last_marked: Optional[date] = None
-    history: str = "[]"  # JSON string of review history
     
     @property
     def history_list(self) -> List[Dict[str, Any]]:
@@ -61,13 +128,14 @@ class Problem:
         """
         self.history = json.dumps(value, default=str)
     
-    def add_history_entry(self, status: str, review_date: date = None) -> None:
+    def add_history_entry(self, status: str, review_date: date = None, **details: Any) -> None:
         """
         Add a new entry to the review history.
         
         Args:
-            status: Review status ('easy', 'hard', 'auto-hard')
+            status: Review status ('easy', 'hard', 'auto-hard', 'reset', 'snooze')
             review_date: Date of review (defaults to today)
+            **details: Extra JSON-serialisable fields to store with the entry
         """
         if review_date is None:
             review_date = date.today()
@@ -75,9 +143,403 @@ class Problem:
         history = self.history_list
         history.append({
             'date': review_date.isoformat(),
-            'status': status
+            'status': status,
+            **details
         })
         self.history_list = history
+    
+    def get_scheduler_state(self, scheduler: str) -> Dict[str, Any]:
+        """
+        Get the state a scheduler stored for this problem or solution.
+        
+        Args:
+            scheduler: Scheduler name (e.g. 'leitner')
+            
+        Returns:
+            dict: The scheduler's state (empty if it has none yet)
+        """
+        try:
+            return dict(json.loads(self.scheduler_state).get(scheduler, {}))
+        except (json.JSONDecodeError, TypeError, AttributeError, ValueError):
+            return {}
+    
+    def set_scheduler_state(self, scheduler: str, state: Dict[str, Any]) -> None:
+        """
+        Store a scheduler's state for this problem or solution, keeping other schedulers' state.
+        
+        Args:
+            scheduler: Scheduler name (e.g. 'leitner')
+            state: JSON-serialisable state to store
+        """
+        try:
+            states = dict(json.loads(self.scheduler_state))
+        except (json.JSONDecodeError, TypeError, ValueError):
+            states = {}
+        states[scheduler] = state
+        self.scheduler_state = json.dumps(states, default=str)
+
+
+@dataclass
+class Problem(ReviewHistoryMixin):
+    """
+    Represents a DSA problem with spaced repetition metadata.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        title: Problem title
+        link: URL or reference link to the problem
+        approach: Detailed explanation of the solution approach
+        code: Code implementation
+        tags: Comma-separated list of topic tags
+        difficulty: Difficulty level ('Easy', 'Medium', 'Hard' or '' if unset)
+        source: Site the problem comes from (e.g. 'LeetCode', '' if unknown)
+        companies: Comma-separated list of companies known to ask the problem
+        status: Scheduling status ('active', or 'inbox' until triaged)
+        streak_level: Current streak level for spaced repetition
+        next_review: Date when the problem should be reviewed next
+        last_marked: Date when the problem was last reviewed (None if never)
+        history: JSON string containing review history
+        scheduler_state: JSON object holding each scheduler's own state
+                         (e.g. the Leitner box), keyed by scheduler name
+    """
+    id: Optional[int] = None
+    title: str = ""
+    link: str = ""
+    approach: str = ""
+    code: str = ""
+    tags: str = ""
+    difficulty: str = ""
+    source: str = ""
+    companies: str = ""
+    status: str = STATUS_ACTIVE
+    streak_level: int = 1
+    next_review: Optional[date] = None
+    last_marked: Optional[date] = None
+    history: str = "[]"  # JSON string of review history
+    scheduler_state: str = "{}"  # JSON object of per-scheduler state
+    
+    @property
+    def tag_list(self) -> List[str]:
+        """
+        Split the comma-separated tags into a list.
+        
+        Returns:
+            List of non-empty, stripped tag names
+        """
+        return split_tags(self.tags)
+    
+    @property
+    def company_list(self) -> List[str]:
+        """
+        Split the comma-separated companies into a list.
+        
+        Returns:
+            List of non-empty, stripped company names
+        """
+        return split_tags(self.companies)
+    
+    @property
+    def missing_fields(self) -> List[str]:
+        """
+        List the descriptive fields that haven't been filled in yet.
+        
+        Returns:
+            List of field names ('tags', 'difficulty', 'approach', 'code') that are empty
+        """
+        missing = []
+        if not self.tag_list:
+            missing.append('tags')
+        if not self.difficulty:
+            missing.append('difficulty')
+        if not (self.approach or "").strip():
+            missing.append('approach')
+        if not (self.code or "").strip():
+            missing.append('code')
+        return missing
+
+
+@dataclass
+class Solution(ReviewHistoryMixin):
+    """
+    Represents one solution to a problem (e.g. brute force in Python).
+    
+    The primary solution is mirrored into the problem's own approach and
+    code fields, so problems without any stored solutions keep working.
+    A solution can also be reviewed on its own language track (e.g.
+    re-implementing in Rust a problem mastered in Python); it is tracked
+    while next_review is set.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        problem_id: ID of the problem this solution belongs to
+        language: Programming language of the code
+        code: Code implementation
+        approach: Explanation of this solution's approach
+        complexity: Time/space complexity notes
+        is_primary: True if this is the problem's main solution
+        streak_level: Current streak level of the language track
+        next_review: Date when the track should be reviewed next (None if untracked)
+        last_marked: Date when the track was last reviewed (None if never)
+        history: JSON string containing the track's review history
+        scheduler_state: JSON object holding each scheduler's own state for
+                         the track, keyed by scheduler name
+    """
+    id: Optional[int] = None
+    problem_id: Optional[int] = None
+    language: str = ""
+    code: str = ""
+    approach: str = ""
+    complexity: str = ""
+    is_primary: bool = False
+    streak_level: int = 1
+    next_review: Optional[date] = None
+    last_marked: Optional[date] = None
+    history: str = "[]"  # JSON string of review history
+    scheduler_state: str = "{}"  # JSON object of per-scheduler state
+    
+    @property
+    def is_tracked(self) -> bool:
+        """
+        Check whether the solution is scheduled on its own language track.
+        
+        Returns:
+            bool: True if the track has a next review date
+        """
+        return self.next_review is not None
+
+
+@dataclass
+class Note:
+    """
+    Represents a free-standing study note (e.g. DSA theory).
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        title: Note title
+        body: Markdown body of the note
+        tags: Comma-separated list of tags
+        problem_id: ID of a related problem (None if not linked)
+        created_at: Date when the note was created
+        updated_at: Date when the note was last modified
+    """
+    id: Optional[int] = None
+    title: str = ""
+    body: str = ""
+    tags: str = ""
+    problem_id: Optional[int] = None
+    created_at: Optional[date] = None
+    updated_at: Optional[date] = None
+    
+    @property
+    def tag_list(self) -> List[str]:
+        """
+        Split the comma-separated tags into a list.
+        
+        Returns:
+            List of non-empty, stripped tag names
+        """
+        return split_tags(self.tags)
+
+
+@dataclass
+class JournalEntry:
+    """
+    Represents a timestamped journal entry about a problem.
+    
+    Journal entries record what happened on a particular attempt (e.g.
+    "forgot the off-by-one in the window") without touching the
+    problem's canonical approach.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        problem_id: ID of the problem the entry is about
+        body: Free text of the entry
+        created_at: When the entry was written
+    """
+    id: Optional[int] = None
+    problem_id: Optional[int] = None
+    body: str = ""
+    created_at: Optional[datetime] = None
+
+
+@dataclass
+class Notification:
+    """
+    Represents an in-app notification about something the app did.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        kind: Event that caused it (one of the NOTIFY_* constants in config)
+        message: Text shown to the user
+        created_at: Date when the notification was created
+        is_read: True once the user has marked it as read
+    """
+    id: Optional[int] = None
+    kind: str = ""
+    message: str = ""
+    created_at: Optional[date] = None
+    is_read: bool = False
+
+
+@dataclass
+class Interview:
+    """
+    Represents a real interview round and the problems that came up in it.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        company: Company the interview was with
+        interview_date: Date of the interview
+        round_name: Kind of round (e.g. "Phone screen", "Onsite 2")
+        outcome: One of INTERVIEW_OUTCOMES
+        notes: Free text notes about the round
+    """
+    id: Optional[int] = None
+    company: str = ""
+    interview_date: Optional[date] = None
+    round_name: str = ""
+    outcome: str = "Pending"
+    notes: str = ""
+
+
+@dataclass
+class FocusSession:
+    """
+    Represents a finished time-boxed review session.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        started_at: When the session started
+        ended_at: When the session ended
+        duration_minutes: Planned length of the session
+        tag: Tag the due problems were filtered by ('' for any)
+        difficulty: Difficulty the due problems were filtered by ('' for any)
+        easy_count: Problems marked Easy during the session
+        hard_count: Problems marked Hard during the session
+    """
+    id: Optional[int] = None
+    started_at: Optional[datetime] = None
+    ended_at: Optional[datetime] = None
+    duration_minutes: int = 0
+    tag: str = ""
+    difficulty: str = ""
+    easy_count: int = 0
+    hard_count: int = 0
+    
+    @property
+    def reviewed(self) -> int:
+        """
+        Count the problems reviewed during the session.
+        
+        Returns:
+            int: Number of Easy and Hard reviews
+        """
+        return self.easy_count + self.hard_count
+
+
+@dataclass
+class Attachment:
+    """
+    Represents a file (e.g. a whiteboard photo) attached to a problem.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        problem_id: ID of the problem the file is attached to
+        filename: Original file name, shown to the user
+        stored_name: Name of the copy in the attachments directory
+        mime_type: MIME type of the file
+        size_bytes: File size in bytes
+        created_at: Date when the file was attached
+    """
+    id: Optional[int] = None
+    problem_id: Optional[int] = None
+    filename: str = ""
+    stored_name: str = ""
+    mime_type: str = ""
+    size_bytes: int = 0
+    created_at: Optional[date] = None
+
+
+@dataclass
+class Collection:
+    """
+    Represents an ordered list of problems to work through (e.g. "Blind 75").
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        name: Collection name
+        description: Free text description (e.g. where the list comes from)
+        created_at: Date when the collection was created
+    """
+    id: Optional[int] = None
+    name: str = ""
+    description: str = ""
+    created_at: Optional[date] = None
+
+
+@dataclass
+class Webhook:
+    """
+    Represents an outgoing webhook that app events are POSTed to.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        url: Address the events are sent to
+        secret: Key used to sign each request body ('' to send unsigned)
+        events: Comma-separated events to send (one of WEBHOOK_EVENTS each)
+        is_active: False while the webhook is paused
+        created_at: Date when the webhook was added
+        channel: How events are sent (one of CHANNELS): signed JSON, or a
+                 Discord or Telegram chat message
+        target: Channel-specific destination (the Telegram chat ID)
+    """
+    id: Optional[int] = None
+    url: str = ""
+    secret: str = ""
+    events: str = ""
+    is_active: bool = True
+    created_at: Optional[date] = None
+    channel: str = "webhook"
+    target: str = ""
+    
+    @property
+    def event_list(self) -> List[str]:
+        """
+        Get the webhook's events as a list.
+        
+        Returns:
+            List of event names
+        """
+        return split_tags(self.events)
+
+
+@dataclass
+class WebhookDelivery:
+    """
+    Represents one event sent (or waiting to be sent) to a webhook.
+    
+    Attributes:
+        id: Unique identifier (auto-generated)
+        webhook_id: ID of the webhook it's sent to
+        event: Event name
+        payload: JSON request body
+        status: 'pending', 'delivered' or 'failed' (gave up retrying)
+        attempts: Number of delivery attempts so far
+        response_code: HTTP status of the last attempt (0 if there was no response)
+        last_error: Why the last attempt failed ('' if it didn't)
+        created_at: When the event happened
+        next_attempt_at: When a pending delivery is tried next
+    """
+    id: Optional[int] = None
+    webhook_id: int = 0
+    event: str = ""
+    payload: str = "{}"
+    status: str = "pending"
+    attempts: int = 0
+    response_code: int = 0
+    last_error: str = ""
+    created_at: Optional[datetime] = None
+    next_attempt_at: Optional[datetime] = None
 
 
 def create_database_schema(cursor: sqlite3.Cursor) -> None:
@@ -95,10 +557,16 @@ def create_database_schema(cursor: sqlite3.Cursor) -> None:
             link TEXT,
             approach TEXT,
             code TEXT,
+            tags TEXT DEFAULT '',
+            difficulty TEXT DEFAULT '',
+            source TEXT DEFAULT '',
+            companies TEXT DEFAULT '',
+            status TEXT DEFAULT 'active',
             streak_level INTEGER DEFAULT 1,
             next_review DATE,
             last_marked DATE,
-            history TEXT DEFAULT '[]'
+            history TEXT DEFAULT '[]',
+            scheduler_state TEXT DEFAULT '{}'
         )
     ''')
     
@@ -111,9 +579,241 @@ def create_database_schema(cursor: sqlite3.Cursor) -> None:
     cursor.execute('''
         CREATE TABLE IF NOT EXISTS streak_tracker (
             date DATE PRIMARY KEY,
-            problems_reviewed INTEGER DEFAULT 0
+            problems_reviewed INTEGER DEFAULT 0,
+            easy_reviewed INTEGER DEFAULT 0,
+            hard_reviewed INTEGER DEFAULT 0
+        )
+    ''')
+    
+    # Create solutions table for additional solutions per problem
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS solutions (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            problem_id INTEGER NOT NULL REFERENCES problems(id) ON DELETE CASCADE,
+            language TEXT DEFAULT '',
+            code TEXT DEFAULT '',
+            approach TEXT DEFAULT '',
+            complexity TEXT DEFAULT '',
+            is_primary INTEGER DEFAULT 0
+        )
+    ''')
+    
+    cursor.execute('''
+        CREATE INDEX IF NOT EXISTS idx_solutions_problem ON solutions(problem_id)
+    ''')
+    
+    # Create notes table for general study notes
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS notes (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            title TEXT NOT NULL,
+            body TEXT DEFAULT '',
+            tags TEXT DEFAULT '',
+            problem_id INTEGER REFERENCES problems(id) ON DELETE SET NULL,
+            created_at DATE,
+            updated_at DATE
+        )
+    ''')
+    
+    # Create settings table for user preferences (key/value pairs)
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS settings (
+            key TEXT PRIMARY KEY,
+            value TEXT
+        )
+    ''')
+    
+    # Create journal table for timestamped per-problem entries
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS journal_entries (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            problem_id INTEGER NOT NULL REFERENCES problems(id) ON DELETE CASCADE,
+            body TEXT NOT NULL,
+            created_at TIMESTAMP
+        )
+    ''')
+    
+    cursor.execute('''
+        CREATE INDEX IF NOT EXISTS idx_journal_problem ON journal_entries(problem_id)
+    ''')
+    
+    # Create notifications table for in-app event messages
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS notifications (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            kind TEXT NOT NULL,
+            message TEXT NOT NULL,
+            created_at DATE,
+            is_read INTEGER DEFAULT 0
+        )
+    ''')
+    
+    # Create interviews table and the problems asked in each interview
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS interviews (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            company TEXT NOT NULL,
+            interview_date DATE,
+            round_name TEXT DEFAULT '',
+            outcome TEXT DEFAULT 'Pending',
+            notes TEXT DEFAULT ''
+        )
+    ''')
+    
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS interview_problems (
+            interview_id INTEGER NOT NULL REFERENCES interviews(id) ON DELETE CASCADE,
+            problem_id INTEGER NOT NULL REFERENCES problems(id) ON DELETE CASCADE,
+            PRIMARY KEY (interview_id, problem_id)
+        )
+    ''')
+    
+    # Create focus_sessions table for finished time-boxed review sessions
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS focus_sessions (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            started_at TIMESTAMP,
+            ended_at TIMESTAMP,
+            duration_minutes INTEGER DEFAULT 0,
+            tag TEXT DEFAULT '',
+            difficulty TEXT DEFAULT '',
+            easy_count INTEGER DEFAULT 0,
+            hard_count INTEGER DEFAULT 0
+        )
+    ''')
+    
+    # Create attachments table for files attached to problems
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS attachments (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            problem_id INTEGER NOT NULL REFERENCES problems(id) ON DELETE CASCADE,
+            filename TEXT NOT NULL,
+            stored_name TEXT NOT NULL,
+            mime_type TEXT DEFAULT '',
+            size_bytes INTEGER DEFAULT 0,
+            created_at DATE
+        )
+    ''')
+    
+    cursor.execute('''
+        CREATE INDEX IF NOT EXISTS idx_attachments_problem ON attachments(problem_id)
+    ''')
+    
+    # Create collections table and the ordered problems in each collection
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS collections (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            name TEXT NOT NULL,
+            description TEXT DEFAULT '',
+            created_at DATE
+        )
+    ''')
+    
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS collection_problems (
+            collection_id INTEGER NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+            problem_id INTEGER NOT NULL REFERENCES problems(id) ON DELETE CASCADE,
+            position INTEGER NOT NULL,
+            PRIMARY KEY (collection_id, problem_id)
+        )
+    ''')
+    
+    # Create webhooks table and the log of events sent to them
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS webhooks (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            url TEXT NOT NULL,
+            secret TEXT DEFAULT '',
+            events TEXT DEFAULT '',
+            is_active INTEGER DEFAULT 1,
+            created_at DATE,
+            channel TEXT DEFAULT 'webhook',
+            target TEXT DEFAULT ''
+        )
+    ''')
+    
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS webhook_deliveries (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            webhook_id INTEGER NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+            event TEXT NOT NULL,
+            payload TEXT NOT NULL,
+            status TEXT DEFAULT 'pending',
+            attempts INTEGER DEFAULT 0,
+            response_code INTEGER DEFAULT 0,
+            last_error TEXT DEFAULT '',
+            created_at TIMESTAMP,
+            next_attempt_at TIMESTAMP
+        )
+    ''')
+    
+    cursor.execute('''
+        CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_status ON webhook_deliveries(status, next_attempt_at)
+    ''')
+    
+    # Create tables for streak freezes (earned, then spent on a missed day)
+    # and for the streak milestones reached
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS streak_freezes (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            earned_on DATE NOT NULL UNIQUE,
+            used_on DATE UNIQUE
+        )
+    ''')
+    
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS streak_badges (
+            days INTEGER PRIMARY KEY,
+            earned_on DATE NOT NULL
+        )
+    ''')
+    
+    # Create trash table holding deleted problems (and their related rows) as JSON
+    cursor.execute('''
+        CREATE TABLE IF NOT EXISTS trash (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            problem_id INTEGER NOT NULL,
+            title TEXT NOT NULL,
+            deleted_at DATE,
+            data TEXT NOT NULL
         )
     ''')
+    
+    # Upgrade databases created before these columns existed
+    add_column_if_missing(cursor, 'problems', 'tags', "TEXT DEFAULT ''")
+    add_column_if_missing(cursor, 'problems', 'difficulty', "TEXT DEFAULT ''")
+    add_column_if_missing(cursor, 'problems', 'status', "TEXT DEFAULT 'active'")
+    add_column_if_missing(cursor, 'problems', 'scheduler_state', "TEXT DEFAULT '{}'")
+    add_column_if_missing(cursor, 'problems', 'source', "TEXT DEFAULT ''")
+    add_column_if_missing(cursor, 'problems', 'companies', "TEXT DEFAULT ''")
+    add_column_if_missing(cursor, 'streak_tracker', 'easy_reviewed', 'INTEGER DEFAULT 0')
+    add_column_if_missing(cursor, 'streak_tracker', 'hard_reviewed', 'INTEGER DEFAULT 0')
+    add_column_if_missing(cursor, 'solutions', 'streak_level', 'INTEGER DEFAULT 1')
+    add_column_if_missing(cursor, 'solutions', 'next_review', 'DATE')
+    add_column_if_missing(cursor, 'solutions', 'last_marked', 'DATE')
+    add_column_if_missing(cursor, 'solutions', 'history', "TEXT DEFAULT '[]'")
+    add_column_if_missing(cursor, 'solutions', 'scheduler_state', "TEXT DEFAULT '{}'")
+    add_column_if_missing(cursor, 'webhooks', 'channel', "TEXT DEFAULT 'webhook'")
+    add_column_if_missing(cursor, 'webhooks', 'target', "TEXT DEFAULT ''")
+
+
+def add_column_if_missing(cursor: sqlite3.Cursor, table: str, column: str, definition: str) -> None:
+    """
+    Add a column to an existing table if it is not already present.
+    
+    SQLite's CREATE TABLE IF NOT EXISTS leaves older tables untouched,
+    so new columns have to be added explicitly for existing databases.
+    
+    Args:
+        cursor: SQLite cursor for executing SQL commands
+        table: Name of the table to alter
+        column: Name of the column to add
+        definition: Column type and constraints (e.g. 'INTEGER DEFAULT 0')
+    """
+    cursor.execute(f'PRAGMA table_info({table})')
+    existing_columns = {row[1] for row in cursor.fetchall()}
+    if column not in existing_columns:
+        cursor.execute(f'ALTER TABLE {table} ADD COLUMN {column} {definition}')
 
 
 def problem_from_row(row: sqlite3.Row) -> Problem:
@@ -132,8 +832,225 @@ def problem_from_row(row: sqlite3.Row) -> Problem:
         link=row['link'],
         approach=row['approach'],
         code=row['code'],
+        tags=row['tags'] or '',
+        difficulty=row['difficulty'] or '',
+        source=row['source'] or '',
+        companies=row['companies'] or '',
+        status=row['status'] or STATUS_ACTIVE,
         streak_level=row['streak_level'],
         next_review=datetime.strptime(row['next_review'], '%Y-%m-%d').date() if row['next_review'] else None,
         last_marked=datetime.strptime(row['last_marked'], '%Y-%m-%d').date() if row['last_marked'] else None,
-        history=row['history']
-    )
\ No newline at end of file
+        history=row['history'],
+        scheduler_state=row['scheduler_state'] or '{}'
+    )
+
+
+def solution_from_row(row: sqlite3.Row) -> Solution:
+    """
+    Convert a database row to a Solution object.
+    
+    Args:
+        row: SQLite row from solutions table
+        
+    Returns:
+        Solution instance populated with row data
+    """
+    return Solution(
+        id=row['id'],
+        problem_id=row['problem_id'],
+        language=row['language'] or '',
+        code=row['code'] or '',
+        approach=row['approach'] or '',
+        complexity=row['complexity'] or '',
+        is_primary=bool(row['is_primary']),
+        streak_level=row['streak_level'] or 1,
+        next_review=datetime.strptime(row['next_review'], '%Y-%m-%d').date() if row['next_review'] else None,
+        last_marked=datetime.strptime(row['last_marked'], '%Y-%m-%d').date() if row['last_marked'] else None,
+        history=row['history'] or '[]',
+        scheduler_state=row['scheduler_state'] or '{}'
+    )
+
+
+def note_from_row(row: sqlite3.Row) -> Note:
+    """
+    Convert a database row to a Note object.
+    
+    Args:
+        row: SQLite row from notes table
+        
+    Returns:
+        Note instance populated with row data
+    """
+    return Note(
+        id=row['id'],
+        title=row['title'],
+        body=row['body'] or '',
+        tags=row['tags'] or '',
+        problem_id=row['problem_id'],
+        created_at=datetime.strptime(row['created_at'], '%Y-%m-%d').date() if row['created_at'] else None,
+        updated_at=datetime.strptime(row['updated_at'], '%Y-%m-%d').date() if row['updated_at'] else None
+    )
+
+
+def journal_entry_from_row(row: sqlite3.Row) -> JournalEntry:
+    """
+    Convert a database row to a JournalEntry object.
+    
+    Args:
+        row: SQLite row from journal_entries table
+        
+    Returns:
+        JournalEntry instance populated with row data
+    """
+    return JournalEntry(
+        id=row['id'],
+        problem_id=row['problem_id'],
+        body=row['body'],
+        created_at=datetime.strptime(row['created_at'], JOURNAL_TIMESTAMP_FORMAT) if row['created_at'] else None
+    )
+
+
+def notification_from_row(row: sqlite3.Row) -> Notification:
+    """
+    Convert a database row to a Notification object.
+    
+    Args:
+        row: SQLite row from notifications table
+        
+    Returns:
+        Notification instance populated with row data
+    """
+    return Notification(
+        id=row['id'],
+        kind=row['kind'],
+        message=row['message'],
+        created_at=datetime.strptime(row['created_at'], '%Y-%m-%d').date() if row['created_at'] else None,
+        is_read=bool(row['is_read'])
+    )
+
+
+def interview_from_row(row: sqlite3.Row) -> Interview:
+    """
+    Convert a database row to an Interview object.
+    
+    Args:
+        row: SQLite row from interviews table
+        
+    Returns:
+        Interview instance populated with row data
+    """
+    return Interview(
+        id=row['id'],
+        company=row['company'],
+        interview_date=datetime.strptime(row['interview_date'], '%Y-%m-%d').date() if row['interview_date'] else None,
+        round_name=row['round_name'] or '',
+        outcome=row['outcome'] or 'Pending',
+        notes=row['notes'] or ''
+    )
+
+
+def focus_session_from_row(row: sqlite3.Row) -> FocusSession:
+    """
+    Convert a database row to a FocusSession object.
+    
+    Args:
+        row: SQLite row from focus_sessions table
+        
+    Returns:
+        FocusSession instance populated with row data
+    """
+    return FocusSession(
+        id=row['id'],
+        started_at=datetime.strptime(row['started_at'], JOURNAL_TIMESTAMP_FORMAT) if row['started_at'] else None,
+        ended_at=datetime.strptime(row['ended_at'], JOURNAL_TIMESTAMP_FORMAT) if row['ended_at'] else None,
+        duration_minutes=row['duration_minutes'] or 0,
+        tag=row['tag'] or '',
+        difficulty=row['difficulty'] or '',
+        easy_count=row['easy_count'] or 0,
+        hard_count=row['hard_count'] or 0
+    )
+
+
+def attachment_from_row(row: sqlite3.Row) -> Attachment:
+    """
+    Convert a database row to an Attachment object.
+    
+    Args:
+        row: SQLite row from attachments table
+        
+    Returns:
+        Attachment instance populated with row data
+    """
+    return Attachment(
+        id=row['id'],
+        problem_id=row['problem_id'],
+        filename=row['filename'],
+        stored_name=row['stored_name'],
+        mime_type=row['mime_type'] or '',
+        size_bytes=row['size_bytes'] or 0,
+        created_at=datetime.strptime(row['created_at'], '%Y-%m-%d').date() if row['created_at'] else None
+    )
+
+
+def collection_from_row(row: sqlite3.Row) -> Collection:
+    """
+    Convert a database row to a Collection object.
+    
+    Args:
+        row: SQLite row from collections table
+        
+    Returns:
+        Collection instance populated with row data
+    """
+    return Collection(
+        id=row['id'],
+        name=row['name'],
+        description=row['description'] or '',
+        created_at=datetime.strptime(row['created_at'], '%Y-%m-%d').date() if row['created_at'] else None
+    )
+
+
+def webhook_from_row(row: sqlite3.Row) -> Webhook:
+    """
+    Convert a database row to a Webhook object.
+    
+    Args:
+        row: SQLite row from webhooks table
+        
+    Returns:
+        Webhook instance populated with row data
+    """
+    return Webhook(
+        id=row['id'],
+        url=row['url'],
+        secret=row['secret'] or '',
+        events=row['events'] or '',
+        is_active=bool(row['is_active']),
+        created_at=datetime.strptime(row['created_at'], '%Y-%m-%d').date() if row['created_at'] else None,
+        channel=row['channel'] or 'webhook',
+        target=row['target'] or ''
+    )
+
+
+def webhook_delivery_from_row(row: sqlite3.Row) -> WebhookDelivery:
+    """
+    Convert a database row to a WebhookDelivery object.
+    
+    Args:
+        row: SQLite row from webhook_deliveries table
+        
+    Returns:
+        WebhookDelivery instance populated with row data
+    """
+    return WebhookDelivery(
+        id=row['id'],
+        webhook_id=row['webhook_id'],
+        event=row['event'],
+        payload=row['payload'],
+        status=row['status'] or 'pending',
+        attempts=row['attempts'] or 0,
+        response_code=row['response_code'] or 0,
+        last_error=row['last_error'] or '',
+        created_at=datetime.fromisoformat(row['created_at']) if row['created_at'] else None,
+        next_attempt_at=datetime.fromisoformat(row['next_attempt_at']) if row['next_attempt_at'] else None
+    )
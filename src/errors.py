@@ -0,0 +1,72 @@
+"""
+DSA Recall - Error types
+
+Library code raises these instead of bare built-in exceptions, so the
+windows can tell a user mistake from a missing record or a bug. Each
+type also subclasses the built-in exception it replaces, so existing
+`except ValueError` and `except KeyError` handlers keep working.
+"""
+
+from typing import Dict
+
+
+class DSARecallError(Exception):
+    """Base class for errors raised by DSA Recall itself."""
+
+
+class ValidationError(DSARecallError, ValueError):
+    """
+    Input that can't be accepted (e.g. an unknown sort field or a bad date).
+    
+    Attributes:
+        errors: Field name to message when a record with several fields
+                was rejected (empty for single values)
+    """
+    
+    def __init__(self, message: str = "", errors: Dict[str, str] = None):
+        """
+        Create the error from a message, field errors or both.
+        
+        Args:
+            message: Error message (built from the field errors if empty)
+            errors: Field name to message
+        """
+        self.errors = dict(errors or {})
+        super().__init__(message or "; ".join(f"{field}: {error}" for field, error in self.errors.items()))
+
+
+class NotFoundError(DSARecallError, KeyError):
+    """A named record or setting that doesn't exist."""
+    
+    def __str__(self) -> str:
+        """Show the message as is (KeyError would quote it)."""
+        return str(self.args[0]) if self.args else ""
+
+
+class ConflictError(DSARecallError, ValueError):
+    """A change that clashes with the current state (e.g. snoozing to an earlier date)."""
+
+
+# How each error type is introduced when shown to the user
+ERROR_LABELS = [
+    (ValidationError, "Invalid input"),
+    (NotFoundError, "Not found"),
+    (ConflictError, "Conflict"),
+]
+
+
+def describe_error(error: Exception) -> str:
+    """
+    Turn an error into a message for the user.
+    
+    Args:
+        error: Exception to describe
+        
+    Returns:
+        str: Message such as 'Invalid input: Unknown sort field', or an
+             'Unexpected error' message for anything that isn't a DSARecallError
+    """
+    for error_type, label in ERROR_LABELS:
+        if isinstance(error, error_type):
+            return f"{label}: {error}"
+    return f"Unexpected error ({type(error).__name__}): {error}"
@@ -10,14 +10,32 @@ import webbrowser
 from datetime import date
 
 from src.database.db_manager import DatabaseManager
+from src.utils.notifications import notify_if_leech, send_scheduled_events
 from src.utils.spaced_repetition import auto_mark_overdue_problems, mark_problem_easy, mark_problem_hard
+from src.utils.streaks import StreakService
+from src.scheduler.factory import get_scheduler
 from src.config import APP_TITLE
+from src.errors import describe_error
 
 from .windows.main_dashboard import show_main_dashboard
 from .windows.add_problem import show_add_problem_window
 from .windows.all_problems import show_all_problems_window
 from .windows.streak_tracker import show_streak_tracker_window
 from .windows.problem_card import show_problem_card_window
+from .windows.import_problems import show_import_problems_window
+from .windows.export_data import show_export_data_window
+from .windows.notes import show_notes_window
+from .windows.search import show_search_window
+from .windows.inbox import show_inbox_window
+from .windows.settings import show_settings_window
+from .windows.statistics import show_statistics_window
+from .windows.notifications import show_notifications_window
+from .windows.interviews import show_interviews_window
+from .windows.collections import show_collections_window
+from .windows.mastery import show_mastery_window
+from .windows.focus_session import show_focus_session_window
+from .windows.batch_review import show_batch_review_window
+from .windows.trash import show_trash_window
 
 
 class DSARecallGUI:
@@ -35,6 +53,9 @@ class DSARecallGUI:
         # Initialize database
         self.db = DatabaseManager()
         self._auto_mark_overdue_problems()
+        self._purge_trash()
+        self._update_streak()
+        self._send_webhook_events()
         
         print("Application initialized successfully!")
         print("Note: This is a simplified GUI implementation for demonstration.")
@@ -44,15 +65,41 @@ class DSARecallGUI:
         """Auto-mark overdue problems as hard on startup."""
         overdue_problems = self.db.get_overdue_problems()
         if overdue_problems:
-            count = auto_mark_overdue_problems(overdue_problems)
+            settings = self.db.get_settings()
+            marked = auto_mark_overdue_problems(overdue_problems, get_scheduler(settings), settings)
             # Update problems in database
-            for problem in overdue_problems:
+            for problem in marked:
                 self.db.update_problem(problem)
+                notify_if_leech(self.db, problem)
             
-            if count > 0:
-                print(f"⚠️  Auto-marked {count} overdue problem(s) as hard")
+            if marked:
+                print(f"⚠️  Auto-marked {len(marked)} overdue problem(s) as hard")
                 print()
     
+    def _purge_trash(self):
+        """Permanently delete problems that have been in the trash too long."""
+        purged = self.db.purge_trash()
+        if purged > 0:
+            print(f"🗑️  Purged {purged} problem(s) from the trash")
+            print()
+    
+    def _update_streak(self):
+        """Spend streak freezes on missed days on startup."""
+        frozen_days = StreakService(self.db).update()['frozen_days']
+        
+        if frozen_days:
+            days = ", ".join(day.isoformat() for day in frozen_days)
+            print(f"🧊 Streak freeze used for {days}, your streak continues")
+            print()
+    
+    def _send_webhook_events(self):
+        """Queue the daily webhook events and send pending deliveries on startup."""
+        sent = send_scheduled_events(self.db)
+        
+        if sent['failed'] > 0:
+            print(f"📡 {sent['failed']} webhook delivery(ies) failed and will be retried later")
+            print()
+    
     def run(self):
         """Run the GUI application."""
         while True:
@@ -68,6 +115,34 @@ class DSARecallGUI:
                     show_all_problems_window(self.db)
                 elif action == 'streak_tracker':
                     show_streak_tracker_window(self.db)
+                elif action == 'import_problems':
+                    show_import_problems_window(self.db)
+                elif action == 'export_data':
+                    show_export_data_window(self.db)
+                elif action == 'notes':
+                    show_notes_window(self.db)
+                elif action == 'search':
+                    show_search_window(self.db)
+                elif action == 'inbox':
+                    show_inbox_window(self.db)
+                elif action == 'settings':
+                    show_settings_window(self.db)
+                elif action == 'statistics':
+                    show_statistics_window(self.db)
+                elif action == 'notifications':
+                    show_notifications_window(self.db)
+                elif action == 'interviews':
+                    show_interviews_window(self.db)
+                elif action == 'collections':
+                    show_collections_window(self.db)
+                elif action == 'mastery':
+                    show_mastery_window(self.db)
+                elif action == 'focus_session':
+                    show_focus_session_window(self.db)
+                elif action == 'batch_review':
+                    show_batch_review_window(self.db)
+                elif action == 'trash':
+                    show_trash_window(self.db)
                 elif action.startswith('view_problem:'):
                     # Extract problem ID from action
                     problem_id = int(action.split(':')[1])
@@ -79,7 +154,7 @@ class DSARecallGUI:
                 print("\n\nGoodbye! 👋")
                 break
             except Exception as e:
-                print(f"Error: {e}")
+                print(f"❌ {describe_error(e)}")
                 input("Press Enter to continue...")
 
 
@@ -4,9 +4,14 @@ Add Problem window for DSA Recall GUI.
 This window allows users to add new DSA problems with external editor integration.
 """
 
-from src.database.models import Problem
-from src.utils.spaced_repetition import initialize_new_problem
+from src.database.models import Problem, normalize_difficulty
+from src.config import DIFFICULTY_LEVELS, KNOWN_COMPANIES
+from src.utils.spaced_repetition import initialize_new_problem, balance_initial_reviews
 from src.utils.editor import edit_approach, edit_code
+from src.utils.tagging import suggest_tags
+from src.utils.leetcode import is_leetcode_url, fetch_problem_metadata
+from src.utils.sources import detect_source, normalize_source, normalize_companies
+from src.utils.validation import validate_title, validate_link
 
 
 def clear_screen():
@@ -34,18 +39,69 @@ def show_add_problem_window(db_manager):
     # Initialize problem
     problem = Problem()
     
+    # Get link first so LeetCode metadata can pre-fill the other fields
+    while True:
+        link = input("Link (optional): ").strip()
+        error = validate_link(link)
+        if not error:
+            break
+        print(f"❌ {error}")
+    problem.link = link
+    
+    existing = db_manager.find_problem_by_link(link)
+    if existing:
+        print(f"⚠️  This link is already saved as '{existing.title}' (ID: {existing.id}).")
+        if input("Add it again anyway? [y/N]: ").strip().lower() not in ['y', 'yes']:
+            return False
+    
+    metadata = None
+    if is_leetcode_url(link):
+        print("🔎 Looking up problem on LeetCode...")
+        metadata = fetch_problem_metadata(link)
+        if metadata:
+            print(f"✅ Found '{metadata['title']}' ({metadata['difficulty'] or 'unknown difficulty'})")
+        else:
+            print("⚠️  Could not fetch LeetCode metadata, please fill in the details")
+    
+    default_title = metadata['title'] if metadata else ""
+    default_tags = ", ".join(metadata['tags']) if metadata else ""
+    
     # Get title
     while True:
-        title = input("Title (required): ").strip()
-        if title:
+        prompt = f"Title (required) [{default_title}]: " if default_title else "Title (required): "
+        title = input(prompt).strip() or default_title
+        error = validate_title(title)
+        if not error:
             problem.title = title
             break
-        else:
-            print("❌ Title is required!")
+        print(f"❌ {error}")
     
-    # Get link
-    link = input("Link (optional): ").strip()
-    problem.link = link
+    # Get tags
+    prompt = f"Tags (comma-separated) [{default_tags}]: " if default_tags else "Tags (comma-separated, optional): "
+    problem.tags = input(prompt).strip() or default_tags
+    
+    # Get difficulty
+    default_difficulty = normalize_difficulty(metadata['difficulty']) if metadata else ""
+    while True:
+        options = "/".join(DIFFICULTY_LEVELS)
+        prompt = f"Difficulty ({options}) [{default_difficulty}]: " if default_difficulty else f"Difficulty ({options}, optional): "
+        difficulty_input = input(prompt).strip()
+        if not difficulty_input:
+            problem.difficulty = default_difficulty
+            break
+        problem.difficulty = normalize_difficulty(difficulty_input)
+        if problem.difficulty:
+            break
+        print(f"❌ Difficulty must be one of: {', '.join(DIFFICULTY_LEVELS)}")
+    
+    # Get source (derived from the link when the site is known)
+    default_source = detect_source(link)
+    prompt = f"Source (e.g. LeetCode) [{default_source}]: " if default_source else "Source (e.g. LeetCode, optional): "
+    problem.source = normalize_source(input(prompt)) or default_source
+    
+    # Get companies
+    print(f"Common companies: {', '.join(KNOWN_COMPANIES)}")
+    problem.companies = normalize_companies(input("Companies that ask it (comma-separated, optional): "))
     
     # Approach section
     print("\nApproach:")
@@ -81,11 +137,23 @@ def show_add_problem_window(db_manager):
         except Exception as e:
             print(f"❌ Failed to open editor: {str(e)}")
     
+    # Offer tag suggestions when no tags were entered
+    if not problem.tag_list:
+        suggested = suggest_tags(problem)
+        if suggested:
+            print(f"\nSuggested tags: {', '.join(suggested)}")
+            if input("Use these tags? [y/N]: ").strip().lower() in ['y', 'yes']:
+                problem.tags = ", ".join(suggested)
+    
     # Show summary
     print("\n" + "=" * 50)
     print("Problem Summary:")
     print(f"Title: {problem.title}")
     print(f"Link: {problem.link or '(not set)'}")
+    print(f"Tags: {', '.join(problem.tag_list) or '(none)'}")
+    print(f"Difficulty: {problem.difficulty or '(not set)'}")
+    print(f"Source: {problem.source or '(not set)'}")
+    print(f"Companies: {', '.join(problem.company_list) or '(none)'}")
     print(f"Approach: {'✅ Set' if problem.approach.strip() else '❌ Not set'}")
     print(f"Code: {'✅ Set' if problem.code.strip() else '❌ Not set'}")
     print()
@@ -96,11 +164,14 @@ def show_add_problem_window(db_manager):
         if confirm in ['y', 'yes']:
             try:
                 # Initialize spaced repetition metadata
-                initialize_new_problem(problem)
+                settings = db_manager.get_settings()
+                initialize_new_problem(problem, settings)
+                balance_initial_reviews([problem], db_manager.get_new_problem_counts(), settings)
                 
                 # Save to database
                 problem_id = db_manager.add_problem(problem)
                 print(f"✅ Problem '{problem.title}' added successfully! (ID: {problem_id})")
+                print(f"First review: {problem.next_review}")
                 input("Press Enter to continue...")
                 return True
             except Exception as e:
@@ -5,7 +5,13 @@ This window shows a list of all problems with search and management options.
 """
 
 from datetime import date
+
+from src.config import DIFFICULTY_LEVELS, STATUS_ACTIVE, STATUS_INBOX, STATUS_ARCHIVED, PROBLEM_SORT_FIELDS, SORT_ORDERS
+from src.database.models import normalize_difficulty
+from src.utils.pagination import paginate
+from src.utils.sources import get_known_sources, normalize_source, normalize_companies
 from src.utils.spaced_repetition import reset_problem_streak
+from src.utils.tagging import suggest_tags_for_untagged, apply_tag_suggestions
 
 
 def clear_screen():
@@ -21,6 +27,16 @@ def show_all_problems_window(db_manager):
     Args:
         db_manager: Database manager instance
     """
+    difficulty_filter = None
+    source_filter = None
+    company_filter = None
+    show_archived = False
+    settings = db_manager.get_settings()
+    sort_field = settings['default_sort']
+    sort_order = settings['default_sort_order']
+    page_size = settings['page_size']
+    page_number = 1
+    
     while True:
         clear_screen()
         
@@ -29,18 +45,37 @@ def show_all_problems_window(db_manager):
         print()
         
         # Get all problems
-        problems = db_manager.get_all_problems()
+        problems = db_manager.get_all_problems(difficulty=difficulty_filter, include_archived=show_archived,
+                                               sort=sort_field, order=sort_order,
+                                               source=source_filter, company=company_filter)
+        filtered = difficulty_filter or source_filter or company_filter
         
-        if not problems:
+        if not problems and not filtered and not db_manager.get_status_counts():
             print("No problems found. Add some problems first!")
             input("Press Enter to continue...")
             return
         
+        if filtered:
+            filters = [f"{difficulty_filter} difficulty" if difficulty_filter else "",
+                       f"from {source_filter}" if source_filter else "",
+                       f"asked by {company_filter}" if company_filter else ""]
+            print(f"Filter: problems {', '.join(part for part in filters if part)}")
+        if show_archived:
+            print("Including archived problems")
+        custom_sort = (sort_field, sort_order) != ("added", "asc")
+        if custom_sort:
+            print(f"Sorted by {sort_field.replace('_', ' ')} ({sort_order})")
+        if filtered or show_archived or custom_sort:
+            print()
+        
         # Display problems in table format
-        print(f"{'ID':<4} {'Title':<30} {'Streak':<6} {'Next Review':<12} {'Last Marked':<12}")
-        print("-" * 70)
+        page = paginate(problems, page_number, page_size)
+        page_number = page['page']
+        
+        print(f"{'ID':<4} {'Title':<30} {'Diff':<6} {'Streak':<6} {'Next Review':<12} {'Last Marked':<12}")
+        print("-" * 77)
         
-        for problem in problems:
+        for problem in page['items']:
             next_review = problem.next_review.strftime("%Y-%m-%d") if problem.next_review else "Not set"
             last_marked = problem.last_marked.strftime("%Y-%m-%d") if problem.last_marked else "Never"
             
@@ -50,18 +85,42 @@ def show_all_problems_window(db_manager):
             # Color coding for due/overdue problems
             today = date.today()
             status = "  "
-            if problem.next_review:
+            if problem.status == STATUS_INBOX:
+                status = "📬"  # Not scheduled yet
+            elif problem.status == STATUS_ARCHIVED:
+                status = "🗄️"  # Out of review rotation
+            elif problem.next_review:
                 if problem.next_review <= today:
                     status = "📅"  # Due today
                 elif problem.next_review < today:
                     status = "🔴"  # Overdue
             
-            print(f"{problem.id:<4} {title:<30} {problem.streak_level:<6} {next_review:<12} {last_marked:<12} {status}")
+            difficulty = problem.difficulty or "-"
+            print(f"{problem.id:<4} {title:<30} {difficulty:<6} {problem.streak_level:<6} {next_review:<12} {last_marked:<12} {status}")
+        
+        if not problems:
+            print("No problems match the filter." if filtered else "No problems found.")
+        elif page['total_pages'] > 1:
+            print(f"\nPage {page['page']} of {page['total_pages']} ({page['total_items']} problems)")
         
         print("\nActions:")
+        if page['has_previous']:
+            print("[<] Previous page")
+        if page['has_next']:
+            print("[>] Next page")
+        if page['total_pages'] > 1:
+            print("[p<N>] Go to page (e.g., p2)")
         print("[v<ID>] View/Edit problem (e.g., v1)")
-        print("[d<ID>] Delete problem (e.g., d1)")
+        print("[d<ID>] Move problem to trash (e.g., d1)")
         print("[t<ID>] Review Today (reset streak, e.g., t1)")
+        print("[m<ID>] Merge a duplicate into problem (e.g., m1)")
+        print("[f] Filter by difficulty")
+        print("[s] Filter by source")
+        print("[c] Filter by company")
+        print("[o] Sort")
+        print(f"[a] {'Hide' if show_archived else 'Show'} archived problems")
+        print("[u] Suggest tags for untagged problems")
+        print("[n] Show problems needing attention")
         print("[r] Refresh list")
         print("[b] Back to main dashboard")
         
@@ -72,6 +131,52 @@ def show_all_problems_window(db_manager):
                 break
             elif choice == 'r':
                 continue  # Refresh by looping
+            elif choice == '<':
+                page_number -= 1
+            elif choice == '>':
+                page_number += 1
+            elif choice.startswith('p') and choice[1:].isdigit():
+                page_number = int(choice[1:])
+            elif choice == 'f':
+                difficulty_input = input(f"Difficulty ({'/'.join(DIFFICULTY_LEVELS)}, leave empty for all): ").strip()
+                difficulty_filter = normalize_difficulty(difficulty_input) or None
+                page_number = 1
+            elif choice == 's':
+                source_input = input(f"Source (e.g. {', '.join(get_known_sources()[:3])}, leave empty for all): ")
+                source_filter = normalize_source(source_input) or None
+                page_number = 1
+            elif choice == 'c':
+                company_filter = normalize_companies(input("Company (leave empty for all): ")) or None
+                page_number = 1
+            elif choice == 'a':
+                show_archived = not show_archived
+                page_number = 1
+            elif choice == 'o':
+                sort_input = input(f"Sort by ({'/'.join(PROBLEM_SORT_FIELDS)}, current: {sort_field}): ").strip().lower()
+                order_input = input(f"Order ({'/'.join(SORT_ORDERS)}, current: {sort_order}): ").strip().lower()
+                if (sort_input and sort_input not in PROBLEM_SORT_FIELDS) or (order_input and order_input not in SORT_ORDERS):
+                    print("Invalid sort option! Please try again.")
+                    input("Press Enter to continue...")
+                else:
+                    sort_field = sort_input or sort_field
+                    sort_order = order_input or sort_order
+                    page_number = 1
+            elif choice == 'n':
+                from .needs_attention import show_needs_attention_window
+                show_needs_attention_window(db_manager)
+            elif choice == 'u':
+                suggestions = suggest_tags_for_untagged(db_manager)
+                if not suggestions:
+                    print("No suggestions for untagged problems.")
+                else:
+                    titles = {problem.id: problem.title for problem in problems}
+                    for problem_id, tags in suggestions.items():
+                        print(f"  {problem_id}. {titles.get(problem_id, '')}: {', '.join(tags)}")
+                    confirm = input("Apply all suggestions? [y/N]: ").strip().lower()
+                    if confirm in ['y', 'yes']:
+                        updated = apply_tag_suggestions(db_manager, suggestions)
+                        print(f"✅ Tagged {updated} problem(s).")
+                input("Press Enter to continue...")
             elif choice.startswith('v'):
                 # View problem
                 try:
@@ -92,11 +197,11 @@ def show_all_problems_window(db_manager):
                     problem_id = int(choice[1:])
                     problem = db_manager.get_problem(problem_id)
                     if problem:
-                        confirm = input(f"Are you sure you want to delete '{problem.title}'? [y/N]: ").strip().lower()
+                        confirm = input(f"Move '{problem.title}' to the trash? [y/N]: ").strip().lower()
                         if confirm in ['y', 'yes']:
-                            success = db_manager.delete_problem(problem_id)
+                            success = db_manager.trash_problem(problem_id)
                             if success:
-                                print(f"✅ Problem '{problem.title}' deleted successfully.")
+                                print(f"✅ Problem '{problem.title}' moved to the trash.")
                             else:
                                 print("❌ Failed to delete problem.")
                             input("Press Enter to continue...")
@@ -106,12 +211,38 @@ def show_all_problems_window(db_manager):
                 except (ValueError, IndexError):
                     print("Invalid problem ID!")
                     input("Press Enter to continue...")
+            elif choice.startswith('m'):
+                # Merge a duplicate
+                try:
+                    problem = db_manager.get_problem(int(choice[1:]))
+                    duplicate = None
+                    if problem:
+                        duplicate_input = input(f"ID of the duplicate to merge into '{problem.title}': ").strip()
+                        duplicate = db_manager.get_problem(int(duplicate_input))
+                    if not problem or not duplicate:
+                        print("Problem not found!")
+                    elif duplicate.id == problem.id:
+                        print("❌ A problem can't be merged into itself.")
+                    else:
+                        print(f"'{duplicate.title}' (ID: {duplicate.id}) will be merged into "
+                              f"'{problem.title}' (ID: {problem.id}) and then deleted.")
+                        print("Its tags, review history, solutions, journal, notes, interviews and attachments move over.")
+                        confirm = input("Merge? [y/N]: ").strip().lower()
+                        if confirm in ['y', 'yes']:
+                            db_manager.merge_problems(problem.id, duplicate.id)
+                            print("✅ Problems merged successfully.")
+                except (ValueError, IndexError):
+                    print("Invalid problem ID!")
+                input("Press Enter to continue...")
             elif choice.startswith('t'):
                 # Review Today
                 try:
                     problem_id = int(choice[1:])
                     problem = db_manager.get_problem(problem_id)
-                    if problem:
+                    if problem and problem.status != STATUS_ACTIVE:
+                        print("❌ Only scheduled problems can be reviewed. Unarchive or promote it first.")
+                        input("Press Enter to continue...")
+                    elif problem:
                         reset_problem_streak(problem)
                         db_manager.update_problem(problem)
                         print(f"✅ Problem '{problem.title}' has been scheduled for review today.")
@@ -0,0 +1,100 @@
+"""
+Attachments window for DSA Recall GUI.
+
+This window lists the files attached to a problem (e.g. whiteboard photos
+of an approach) and lets users add, open and delete them.
+"""
+
+import webbrowser
+
+from src.config import ATTACHMENT_MAX_BYTES, ATTACHMENT_TYPES, ATTACHMENTS_AREA
+from src.storage.factory import get_storage
+from src.utils.attachments import store_attachment, format_size
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_attachments_window(db_manager, problem):
+    """
+    Show the attachments list for a problem.
+    
+    Args:
+        db_manager: Database manager instance
+        problem: Problem instance whose attachments are managed
+    """
+    while True:
+        clear_screen()
+        
+        print(f"📎 Attachments: {problem.title}")
+        print("=" * 60)
+        print()
+        
+        attachments = db_manager.get_attachments(problem.id)
+        
+        if not attachments:
+            print("No files attached yet.")
+        else:
+            print(f"{'ID':<4} {'File':<32} {'Size':<10} {'Added':<12}")
+            print("-" * 60)
+            
+            for attachment in attachments:
+                filename = attachment.filename[:30] + ".." if len(attachment.filename) > 32 else attachment.filename
+                print(f"{attachment.id:<4} {filename:<32} {format_size(attachment.size_bytes):<10} "
+                      f"{str(attachment.created_at or '-'):<12}")
+        
+        print("\nActions:")
+        print("[n] Attach a file")
+        if attachments:
+            print("[o<ID>] Open file (e.g., o1)")
+            print("[d<ID>] Delete file (e.g., d1)")
+        print("[b] Back to problem card")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                print(f"Allowed: {', '.join(sorted(ATTACHMENT_TYPES))} (up to {format_size(ATTACHMENT_MAX_BYTES)})")
+                file_path = input("File path (leave empty to cancel): ").strip()
+                if file_path:
+                    try:
+                        attachment = store_attachment(db_manager, problem.id, file_path)
+                        print(f"✅ Attached '{attachment.filename}'! (ID: {attachment.id})")
+                    except (OSError, ValueError) as e:
+                        print(f"❌ Failed to attach file: {str(e)}")
+                    input("Press Enter to continue...")
+            elif choice.startswith(('o', 'd')):
+                try:
+                    attachment = db_manager.get_attachment(int(choice[1:]))
+                except (ValueError, IndexError):
+                    print("Invalid attachment ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                if not attachment or attachment.problem_id != problem.id:
+                    print("Attachment not found!")
+                    input("Press Enter to continue...")
+                elif choice[0] == 'o':
+                    storage = get_storage(ATTACHMENTS_AREA)
+                    if storage.exists(attachment.stored_name):
+                        webbrowser.open(storage.url(attachment.stored_name))
+                    else:
+                        print(f"❌ The stored file is missing: {attachment.stored_name}")
+                        input("Press Enter to continue...")
+                else:
+                    confirm = input(f"Are you sure you want to delete '{attachment.filename}'? [y/N]: ").strip().lower()
+                    if confirm in ['y', 'yes']:
+                        db_manager.delete_attachment(attachment.id)
+                        print("✅ Attachment deleted successfully.")
+                        input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
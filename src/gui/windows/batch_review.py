@@ -0,0 +1,133 @@
+"""
+Batch Review window for DSA Recall GUI.
+
+This window grades a shuffled batch of due problems and saves all the
+grades together at the end, so an interrupted session changes nothing.
+"""
+
+import random
+
+from src.config import BATCH_REVIEW_SIZE
+from src.scheduler.factory import get_scheduler
+from src.utils.notifications import notify_if_streak_milestone, notify_if_leech, notify_review_completed
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def ask_grades(batch):
+    """
+    Ask for a grade for each problem in a batch.
+    
+    Args:
+        batch: Problems to grade
+        
+    Returns:
+        dict: Problem ID to 'easy' or 'hard' (skipped problems are left out)
+    """
+    grades = {}
+    
+    for index, problem in enumerate(batch, 1):
+        clear_screen()
+        print(f"🗂️  Batch Review ({index}/{len(batch)})")
+        print("=" * 60)
+        print()
+        print(f"Title: {problem.title}")
+        print(f"Difficulty: {problem.difficulty or '(not set)'}")
+        print(f"Tags: {', '.join(problem.tag_list) or '(none)'}")
+        print(f"Link: {problem.link or '(not set)'}")
+        print()
+        
+        while True:
+            choice = input("[e] Easy ✅  [h] Hard ❌  [s] Skip: ").strip().lower()
+            if choice in ('e', 'h', 's'):
+                break
+            print("Invalid choice! Please try again.")
+        
+        if choice == 'e':
+            grades[problem.id] = 'easy'
+        elif choice == 'h':
+            grades[problem.id] = 'hard'
+    
+    return grades
+
+
+def show_batch_review_window(db_manager):
+    """
+    Show the batch review window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    clear_screen()
+    
+    print("🗂️  Batch Review")
+    print("=" * 30)
+    print()
+    
+    due_problems, overflow = db_manager.get_review_queue()
+    if not due_problems:
+        if overflow:
+            print(f"✅ Daily review limit reached ({overflow} more due problem(s) can wait).")
+        else:
+            print("🎉 No problems due for review today!")
+        input("Press Enter to continue...")
+        return
+    
+    size_input = input(f"Batch size ({len(due_problems)} due, default: {BATCH_REVIEW_SIZE}): ").strip()
+    if size_input and (not size_input.isdigit() or int(size_input) < 1):
+        print("❌ Enter a positive number.")
+        input("Press Enter to continue...")
+        return
+    batch = random.sample(due_problems, min(int(size_input or BATCH_REVIEW_SIZE), len(due_problems)))
+    
+    try:
+        grades = ask_grades(batch)
+    except KeyboardInterrupt:
+        print("\n⚠️  Batch cancelled. No grades were saved.")
+        input("Press Enter to continue...")
+        return
+    
+    clear_screen()
+    easy_count = sum(1 for grade in grades.values() if grade == 'easy')
+    print(f"Graded {len(grades)} of {len(batch)} problem(s): {easy_count} easy / {len(grades) - easy_count} hard")
+    if not grades:
+        input("Press Enter to continue...")
+        return
+    
+    confirm = input("Save these grades? [Y/n]: ").strip().lower()
+    if confirm not in ['', 'y', 'yes']:
+        print("⚠️  No grades were saved.")
+        input("Press Enter to continue...")
+        return
+    
+    settings = db_manager.get_settings()
+    scheduler = get_scheduler(settings)
+    reviews = []
+    for problem in batch:
+        grade = grades.get(problem.id)
+        if grade == 'easy':
+            scheduler.mark_easy(problem, settings)
+        elif grade == 'hard':
+            scheduler.mark_hard(problem, settings)
+        else:
+            continue
+        reviews.append((problem, grade))
+    
+    try:
+        db_manager.save_review_batch(reviews)
+    except Exception as e:
+        print(f"❌ Failed to save grades, nothing was changed: {str(e)}")
+        input("Press Enter to continue...")
+        return
+    
+    notify_if_streak_milestone(db_manager)
+    for problem, grade in reviews:
+        if grade == 'hard':
+            notify_if_leech(db_manager, problem)
+        notify_review_completed(db_manager, problem, grade)
+    print(f"✅ Saved {len(reviews)} review(s).")
+    input("Press Enter to continue...")
@@ -0,0 +1,272 @@
+"""
+Collections window for DSA Recall GUI.
+
+This window manages ordered problem lists (e.g. "Blind 75") and shows how
+far along each one is.
+"""
+
+from datetime import date
+
+from src.config import STATUS_ACTIVE, STATUS_INBOX
+from src.database.models import Collection
+from src.utils.editor import edit_approach
+from src.utils.spaced_repetition import get_progress, is_mastered
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def format_progress(progress):
+    """
+    Format a collection's progress for display.
+    
+    Args:
+        progress: Progress dictionary returned by get_progress
+        
+    Returns:
+        str: Progress such as '12/75 mastered (16%)'
+    """
+    if not progress['total']:
+        return "empty"
+    return f"{progress['mastered']}/{progress['total']} mastered ({progress['mastered'] / progress['total']:.0%})"
+
+
+def problem_marker(problem):
+    """
+    Pick the marker shown next to a problem in a collection.
+    
+    Args:
+        problem: Problem instance
+        
+    Returns:
+        str: Emoji for mastered, due, inbox or not yet reviewed problems
+    """
+    if is_mastered(problem):
+        return "🎓"
+    if problem.status == STATUS_INBOX:
+        return "📬"
+    if problem.status == STATUS_ACTIVE and problem.next_review and problem.next_review <= date.today():
+        return "📅"
+    return "  " if problem.last_marked else "🆕"
+
+
+def parse_problem_ids(text):
+    """
+    Parse a list of problem IDs.
+    
+    Args:
+        text: Comma- or space-separated IDs, e.g. '3, 7 12'
+        
+    Returns:
+        List of IDs, or None if any of them isn't a number
+    """
+    parts = text.replace(',', ' ').split()
+    if not all(part.isdigit() for part in parts):
+        return None
+    return [int(part) for part in parts]
+
+
+def show_collections_window(db_manager):
+    """
+    Show the collections window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("📚 Collections")
+        print("=" * 30)
+        print()
+        
+        collections = db_manager.get_all_collections()
+        
+        if not collections:
+            print("No collections yet. Create one for a roadmap like Blind 75 or Grind 169.")
+        else:
+            print(f"{'ID':<4} {'Name':<30} {'Progress':<28}")
+            print("-" * 62)
+            
+            for collection in collections:
+                name = collection.name[:28] + ".." if len(collection.name) > 30 else collection.name
+                progress = get_progress(db_manager.get_collection_problems(collection.id))
+                print(f"{collection.id:<4} {name:<30} {format_progress(progress):<28}")
+        
+        print("\nActions:")
+        print("[n] New collection")
+        if collections:
+            print("[v<ID>] View/Edit collection (e.g., v1)")
+            print("[d<ID>] Delete collection, keeping its problems (e.g., d1)")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                name = input("Name (required): ").strip()
+                if not name:
+                    print("❌ Name is required!")
+                    input("Press Enter to continue...")
+                    continue
+                description = input("Description (optional): ").strip()
+                collection_id = db_manager.add_collection(Collection(name=name, description=description))
+                show_collection_window(db_manager, db_manager.get_collection(collection_id))
+            elif choice.startswith(('v', 'd')):
+                try:
+                    collection = db_manager.get_collection(int(choice[1:]))
+                except (ValueError, IndexError):
+                    print("Invalid collection ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                if not collection:
+                    print("Collection not found!")
+                    input("Press Enter to continue...")
+                elif choice[0] == 'v':
+                    show_collection_window(db_manager, collection)
+                else:
+                    confirm = input(f"Are you sure you want to delete '{collection.name}'? [y/N]: ").strip().lower()
+                    if confirm in ['y', 'yes']:
+                        db_manager.delete_collection(collection.id)
+                        print("✅ Collection deleted. Its problems were kept.")
+                        input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
+
+
+def show_collection_window(db_manager, collection):
+    """
+    Show a single collection with its problems in order.
+    
+    Adding, removing and moving problems takes effect immediately; the
+    name and description are stored when saved.
+    
+    Args:
+        db_manager: Database manager instance
+        collection: Collection instance to display
+    """
+    while True:
+        clear_screen()
+        
+        problems = db_manager.get_collection_problems(collection.id)
+        progress = get_progress(problems)
+        
+        print(f"Collection: {collection.name}")
+        print("=" * 60)
+        print()
+        if collection.description.strip():
+            print(collection.description.strip())
+            print()
+        
+        print(f"Progress: {format_progress(progress)}")
+        print(f"Reviewed at least once: {progress['reviewed']}/{progress['total']}")
+        print(f"Due now: {progress['due']}")
+        print()
+        
+        if not problems:
+            print("No problems in this collection yet.")
+        for position, problem in enumerate(problems, 1):
+            title = problem.title[:43] + ".." if len(problem.title) > 45 else problem.title
+            print(f"{position:>3}. {problem_marker(problem)} {title:<45} (ID: {problem.id})")
+        print()
+        print("🎓 mastered  📅 due  🆕 not reviewed yet  📬 in inbox")
+        
+        print("\nActions:")
+        print("[n] Edit name")
+        print("[e] Edit description (external editor)")
+        print("[p] Add problems (IDs, e.g. 3, 7, 12)")
+        if problems:
+            print("[v<ID>] View/Edit problem (e.g., v3)")
+            print("[u<ID>] Remove problem from collection (e.g., u3)")
+            print("[k<ID>] Move problem to another position (e.g., k3)")
+        print("[s] Save changes")
+        print("[b] Back to collections")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                name = input(f"Enter name (current: {collection.name}): ").strip()
+                if name:
+                    collection.name = name
+                    print("✅ Name updated!")
+                else:
+                    print("❌ Name cannot be empty!")
+                input("Press Enter to continue...")
+            elif choice == 'e':
+                try:
+                    edited_description = edit_approach(collection.description)
+                    if edited_description is not None:
+                        collection.description = edited_description
+                        print("✅ Description updated!")
+                    else:
+                        print("⚠️  Editing cancelled")
+                except Exception as e:
+                    print(f"❌ Failed to open editor: {str(e)}")
+                input("Press Enter to continue...")
+            elif choice == 'p':
+                problem_ids = parse_problem_ids(input("Problem IDs to add: "))
+                if problem_ids is None:
+                    print("Invalid problem ID!")
+                else:
+                    for problem_id in problem_ids:
+                        problem = db_manager.get_problem(problem_id)
+                        if not problem:
+                            print(f"Problem {problem_id} not found!")
+                        elif db_manager.add_problem_to_collection(collection.id, problem_id):
+                            print(f"✅ Added '{problem.title}'!")
+                        else:
+                            print(f"⚠️  '{problem.title}' is already in this collection.")
+                input("Press Enter to continue...")
+            elif choice.startswith(('v', 'u', 'k')) and len(choice) > 1:
+                try:
+                    problem_id = int(choice[1:])
+                except ValueError:
+                    print("Invalid problem ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                problem = next((problem for problem in problems if problem.id == problem_id), None)
+                if not problem:
+                    print("That problem isn't in this collection.")
+                    input("Press Enter to continue...")
+                elif choice[0] == 'v':
+                    from .problem_card import show_problem_card_window
+                    show_problem_card_window(db_manager, problem)
+                elif choice[0] == 'u':
+                    db_manager.remove_problem_from_collection(collection.id, problem_id)
+                    print(f"✅ Removed '{problem.title}' from the collection.")
+                    input("Press Enter to continue...")
+                else:
+                    position = input(f"New position (1-{len(problems)}): ").strip()
+                    if position.isdigit() and int(position) >= 1:
+                        db_manager.move_collection_problem(collection.id, problem_id, int(position))
+                        print("✅ Problem moved!")
+                    else:
+                        print("❌ Enter a position number.")
+                    input("Press Enter to continue...")
+            elif choice == 's':
+                try:
+                    db_manager.update_collection(collection)
+                    print("✅ Collection saved successfully!")
+                except Exception as e:
+                    print(f"❌ Failed to save collection: {str(e)}")
+                input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
@@ -0,0 +1,81 @@
+"""
+Export Data window for DSA Recall GUI.
+
+This window exports problems, review history, notes and activity
+for backup or use in other tools.
+"""
+
+from src.utils.exporter import (
+    export_json, export_csv, export_anki_tsv, export_anki_revlog, export_daily_stats_csv, export_code_zip,
+    export_deck
+)
+from src.utils.notifications import notify_export_finished
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_export_data_window(db_manager):
+    """
+    Show the export data window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    clear_screen()
+    
+    print("📤 Export Data")
+    print("=" * 30)
+    print()
+    print("[1] JSON (single file)")
+    print("[2] CSV (one file per table in a directory)")
+    print("[3] Anki flashcards (tab-separated, import via File > Import)")
+    print("[4] Daily statistics (one CSV row per day, for spreadsheets and dashboards)")
+    print("[5] Review history in Anki revlog format (CSV)")
+    print("[6] Code solutions (zip of topic/problem folders, ready to commit to Git)")
+    print("[7] Shareable deck (problems without your schedule, for others to import)")
+    print("[b] Back to main dashboard")
+    print()
+    
+    choice = input("Choose format: ").strip().lower()
+    
+    if choice == '1':
+        destination = input("Output file (default: dsarecall-export.json): ").strip() or "dsarecall-export.json"
+        exporter = export_json
+    elif choice == '2':
+        destination = input("Output directory (default: dsarecall-export): ").strip() or "dsarecall-export"
+        exporter = export_csv
+    elif choice == '3':
+        destination = input("Output file (default: dsarecall-anki.txt): ").strip() or "dsarecall-anki.txt"
+        exporter = export_anki_tsv
+    elif choice == '4':
+        destination = input("Output file (default: dsarecall-daily-stats.csv): ").strip() or "dsarecall-daily-stats.csv"
+        exporter = export_daily_stats_csv
+    elif choice == '5':
+        destination = input("Output file (default: dsarecall-revlog.csv): ").strip() or "dsarecall-revlog.csv"
+        exporter = export_anki_revlog
+    elif choice == '6':
+        destination = input("Output file (default: dsarecall-code.zip): ").strip() or "dsarecall-code.zip"
+        exporter = export_code_zip
+    elif choice == '7':
+        destination = input("Output file (default: dsarecall-deck.json): ").strip() or "dsarecall-deck.json"
+        tag = input("Only problems tagged (leave empty for all): ").strip()
+        name = input(f"Deck name (default: {tag or 'DSA problems'}): ").strip() or tag or "DSA problems"
+        include_solutions = input("Include your approach and code? [y/N]: ").strip().lower() in ['y', 'yes']
+        
+        def exporter(db_manager, destination):
+            return export_deck(db_manager, destination, name, tag, include_solutions)
+    else:
+        return
+    
+    try:
+        path = exporter(db_manager, destination)
+        notify_export_finished(db_manager, path)
+        print(f"✅ Data exported to {path}")
+    except (OSError, ValueError) as e:
+        print(f"❌ Failed to export data: {str(e)}")
+    
+    input("Press Enter to continue...")
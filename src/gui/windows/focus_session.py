@@ -0,0 +1,245 @@
+"""
+Focus Session window for DSA Recall GUI.
+
+This window runs time-boxed (Pomodoro-style) review sessions: due problems
+are served one at a time until the time runs out, then the session is
+summarized and stored.
+"""
+
+import webbrowser
+from datetime import datetime, timedelta
+
+from src.config import DIFFICULTY_LEVELS, FOCUS_DEFAULT_MINUTES, FOCUS_MAX_MINUTES
+from src.database.models import FocusSession, normalize_difficulty
+from src.scheduler.factory import get_scheduler
+from src.utils.notifications import notify_if_streak_milestone, notify_if_leech, notify_review_completed
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def matches_focus_filters(problem, tag, difficulty):
+    """
+    Check whether a problem matches a session's filters.
+    
+    Args:
+        problem: Problem instance
+        tag: Required tag ('' for any)
+        difficulty: Required difficulty ('' for any)
+        
+    Returns:
+        bool: True if the problem should be served in the session
+    """
+    if tag and tag.lower() not in (problem_tag.lower() for problem_tag in problem.tag_list):
+        return False
+    return not difficulty or problem.difficulty == difficulty
+
+
+def ask_duration():
+    """
+    Ask for the length of a focus session.
+    
+    Returns:
+        int: Duration in minutes, or None if the input was invalid
+    """
+    answer = input(f"Duration in minutes (default: {FOCUS_DEFAULT_MINUTES}, max: {FOCUS_MAX_MINUTES}): ").strip()
+    if not answer:
+        return FOCUS_DEFAULT_MINUTES
+    if not answer.isdigit() or not 1 <= int(answer) <= FOCUS_MAX_MINUTES:
+        print(f"❌ Enter a number of minutes between 1 and {FOCUS_MAX_MINUTES}.")
+        return None
+    return int(answer)
+
+
+def format_remaining(remaining):
+    """
+    Format the time left in a session as minutes and seconds.
+    
+    Args:
+        remaining: timedelta left until the session ends
+        
+    Returns:
+        str: Time such as '12:05'
+    """
+    seconds = max(0, int(remaining.total_seconds()))
+    return f"{seconds // 60}:{seconds % 60:02d}"
+
+
+def print_session_summary(session):
+    """
+    Print the results of a finished focus session.
+    
+    Args:
+        session: Finished FocusSession instance
+    """
+    minutes = int((session.ended_at - session.started_at).total_seconds() // 60)
+    print(f"\nSession length: {minutes} of {session.duration_minutes} minutes")
+    print(f"Reviewed: {session.reviewed} ({session.easy_count} easy / {session.hard_count} hard)")
+    if session.reviewed:
+        print(f"Easy rate: {session.easy_count / session.reviewed:.0%}")
+
+
+def run_focus_session(db_manager, session):
+    """
+    Serve matching due problems until the session's time is up.
+    
+    Problems are only served while time remains. A problem served before
+    the deadline can still be graded after it, but no new one is shown.
+    
+    Args:
+        db_manager: Database manager instance
+        session: New FocusSession with started_at, duration and filters set
+        
+    Returns:
+        str: Why the session ended ('time', 'done' or 'stopped')
+    """
+    deadline = session.started_at + timedelta(minutes=session.duration_minutes)
+    skipped = set()
+    
+    while True:
+        remaining = deadline - datetime.now()
+        if remaining <= timedelta(0):
+            return 'time'
+        
+        problems = [problem for problem in db_manager.get_review_queue()[0]
+                    if problem.id not in skipped and matches_focus_filters(problem, session.tag, session.difficulty)]
+        if not problems:
+            return 'done'
+        problem = problems[0]
+        
+        clear_screen()
+        print(f"⏱️  Focus Session | {format_remaining(remaining)} left | "
+              f"Reviewed {session.reviewed} ({session.easy_count} easy / {session.hard_count} hard)")
+        print("=" * 60)
+        print()
+        print(f"Title: {problem.title}")
+        print(f"Difficulty: {problem.difficulty or '(not set)'}")
+        print(f"Tags: {', '.join(problem.tag_list) or '(none)'}")
+        print(f"Link: {problem.link or '(not set)'}")
+        print(f"Matching problems left: {len(problems)}")
+        print()
+        print("[e] Mark as Easy ✅")
+        print("[h] Mark as Hard ❌")
+        if problem.link:
+            print("[o] Open link in browser")
+        print("[s] Skip for this session")
+        print("[q] End session")
+        
+        choice = input("\nEnter your choice: ").strip().lower()
+        
+        if choice == 'q':
+            return 'stopped'
+        elif choice == 'e':
+            settings = db_manager.get_settings()
+            get_scheduler(settings).mark_easy(problem, settings)
+            db_manager.save_review(problem, 'easy')
+            notify_if_streak_milestone(db_manager)
+            notify_review_completed(db_manager, problem, 'easy')
+            session.easy_count += 1
+        elif choice == 'h':
+            settings = db_manager.get_settings()
+            get_scheduler(settings).mark_hard(problem, settings)
+            db_manager.save_review(problem, 'hard')
+            notify_if_streak_milestone(db_manager)
+            notify_if_leech(db_manager, problem)
+            notify_review_completed(db_manager, problem, 'hard')
+            session.hard_count += 1
+        elif choice == 's':
+            skipped.add(problem.id)
+        elif choice == 'o' and problem.link:
+            webbrowser.open(problem.link)
+        else:
+            print("Invalid choice! Please try again.")
+            input("Press Enter to continue...")
+
+
+def start_focus_session(db_manager):
+    """
+    Ask for a session's length and filters, run it and store the results.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    duration = ask_duration()
+    if duration is None:
+        input("Press Enter to continue...")
+        return
+    
+    tag = input("Only problems tagged (leave empty for any): ").strip()
+    difficulty_input = input(f"Only difficulty ({'/'.join(DIFFICULTY_LEVELS)}, leave empty for any): ").strip()
+    difficulty = normalize_difficulty(difficulty_input)
+    if difficulty_input and not difficulty:
+        print("❌ Unknown difficulty.")
+        input("Press Enter to continue...")
+        return
+    
+    session = FocusSession(started_at=datetime.now(), duration_minutes=duration, tag=tag, difficulty=difficulty)
+    try:
+        reason = run_focus_session(db_manager, session)
+    except KeyboardInterrupt:
+        reason = 'stopped'
+    session.ended_at = datetime.now()
+    
+    clear_screen()
+    if reason == 'time':
+        print("⏰ Time's up!")
+    elif reason == 'done':
+        print("🎉 No more matching problems are due!")
+    else:
+        print("⏹️  Session ended.")
+    
+    print_session_summary(session)
+    if session.reviewed:
+        db_manager.add_focus_session(session)
+    input("\nPress Enter to continue...")
+
+
+def show_focus_session_window(db_manager):
+    """
+    Show recent focus sessions and start new ones.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("⏱️  Focus Sessions")
+        print("=" * 30)
+        print()
+        
+        sessions = db_manager.get_focus_sessions()
+        
+        if not sessions:
+            print("No focus sessions yet.")
+        else:
+            print(f"{'Started':<18} {'Minutes':<8} {'Filter':<20} {'Easy':<6} {'Hard':<6}")
+            print("-" * 62)
+            
+            for session in sessions:
+                filters = ", ".join(value for value in (session.tag, session.difficulty) if value) or "-"
+                filters = filters[:18] + ".." if len(filters) > 20 else filters
+                started = session.started_at.strftime('%Y-%m-%d %H:%M') if session.started_at else "-"
+                print(f"{started:<18} {session.duration_minutes:<8} {filters:<20} "
+                      f"{session.easy_count:<6} {session.hard_count:<6}")
+        
+        print("\nActions:")
+        print("[n] Start a new session")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                start_focus_session(db_manager)
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
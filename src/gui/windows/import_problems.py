@@ -0,0 +1,212 @@
+"""
+Import Problems window for DSA Recall GUI.
+
+This window imports problems in bulk from a CSV or JSON file.
+"""
+
+from src.utils.importer import (
+    import_problems, detect_columns, write_error_report, load_settings, import_settings, load_deck_info,
+    IMPORT_FIELDS
+)
+from src.utils.notifications import notify_import_finished
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def print_import_summary(summary, dry_run):
+    """
+    Print the result of an import run.
+    
+    Args:
+        summary: Summary dictionary returned by import_problems
+        dry_run: Whether the import was a dry run
+    """
+    created_label = "Would create" if dry_run else "Created"
+    print(f"\n{created_label}: {summary['created']}")
+    print(f"Skipped: {len(summary['skipped'])}")
+    print(f"Errors: {len(summary['errors'])}")
+    if summary['last_first_review']:
+        print(f"First reviews scheduled up to: {summary['last_first_review']}")
+    
+    for entry in summary['skipped']:
+        print(f"  ⚠️  Row {entry['row']}: {entry['message']}")
+    for entry in summary['errors']:
+        print(f"  ❌ Row {entry['row']}: {entry['message']}")
+
+
+def print_progress(processed, total):
+    """
+    Print import progress on a single, continuously updated line.
+    
+    Args:
+        processed: Number of rows processed so far
+        total: Total number of rows
+    """
+    end = "\n" if processed >= total else ""
+    print(f"\r⏳ Processed {processed}/{total} rows", end=end, flush=True)
+
+
+def offer_error_report(summary):
+    """
+    Offer to save skipped and failed rows to a CSV report.
+    
+    Args:
+        summary: Summary dictionary returned by import_problems
+    """
+    if not summary['skipped'] and not summary['errors']:
+        return
+    
+    report_path = input("\nSave error report to (leave empty to skip): ").strip()
+    if not report_path:
+        return
+    
+    try:
+        path = write_error_report(summary, report_path)
+        print(f"✅ Error report saved to {path}")
+    except OSError as e:
+        print(f"❌ Failed to save error report: {str(e)}")
+
+
+def offer_settings_restore(file_path):
+    """
+    Offer to restore the settings saved in a JSON export.
+    
+    Args:
+        file_path: Path to the import file
+        
+    Returns:
+        dict: Settings to restore (empty if there are none or the user declined)
+    """
+    try:
+        settings = load_settings(file_path)
+    except (OSError, ValueError):
+        return {}
+    if not settings:
+        return {}
+    
+    print(f"\nThis file also contains {len(settings)} setting(s):")
+    for key, value in settings.items():
+        print(f"  {key}: {value}")
+    if input("Restore these settings? [y/N]: ").strip().lower() in ['y', 'yes']:
+        return settings
+    return {}
+
+
+def ask_column_mapping(detected):
+    """
+    Show the detected columns and let the user map them to problem fields.
+    
+    Args:
+        detected: Result of detect_columns for the import file
+        
+    Returns:
+        dict: Problem field to column name
+    """
+    columns = detected['columns']
+    
+    print(f"\nFound {detected['row_count']} row(s) with columns:")
+    for i, column in enumerate(columns, 1):
+        samples = [str(row.get(column, ''))[:20] for row in detected['samples'] if isinstance(row, dict)]
+        print(f"  [{i}] {column}  (e.g. {' | '.join(samples)})")
+    
+    print("\nChoose the column for each field (number or name, '-' to skip, Enter to keep):")
+    mapping = {}
+    for field in IMPORT_FIELDS:
+        current = detected['suggested_mapping'].get(field, '')
+        while True:
+            answer = input(f"  {field} [{current or '-'}]: ").strip()
+            if not answer:
+                column = current
+            elif answer == '-':
+                column = ''
+            elif answer.isdigit() and 1 <= int(answer) <= len(columns):
+                column = columns[int(answer) - 1]
+            elif answer in columns:
+                column = answer
+            else:
+                print("  ❌ Unknown column, try again.")
+                continue
+            break
+        if column:
+            mapping[field] = column
+    
+    return mapping
+
+
+def show_import_problems_window(db_manager):
+    """
+    Show the import problems window.
+    
+    Args:
+        db_manager: Database manager instance
+        
+    Returns:
+        bool: True if problems were imported, False otherwise
+    """
+    clear_screen()
+    
+    print("📥 Import Problems")
+    print("=" * 30)
+    print()
+    print("Supported formats: .csv (with header row), .json (list of objects) and shared decks")
+    print(f"Problem fields: {', '.join(IMPORT_FIELDS)}")
+    print()
+    
+    file_path = input("File path (leave empty to cancel): ").strip()
+    if not file_path:
+        return False
+    
+    try:
+        detected = detect_columns(file_path)
+    except (OSError, ValueError) as e:
+        print(f"❌ Failed to read file: {str(e)}")
+        input("Press Enter to continue...")
+        return False
+    
+    deck = load_deck_info(file_path)
+    if deck:
+        print(f"\n📦 Shared deck '{deck['name']}' with {deck['problem_count']} problem(s).")
+        print("Imported problems get fresh schedules, as if you had just added them.")
+    
+    mapping = ask_column_mapping(detected)
+    if 'title' not in mapping:
+        print("❌ A column must be chosen for the title.")
+        input("Press Enter to continue...")
+        return False
+    
+    dry_run = input("\nDry run only? [y/N]: ").strip().lower() in ['y', 'yes']
+    
+    try:
+        summary = import_problems(db_manager, file_path, dry_run=dry_run, mapping=mapping,
+                                  progress=print_progress)
+    except (OSError, ValueError) as e:
+        print(f"❌ Failed to import: {str(e)}")
+        input("Press Enter to continue...")
+        return False
+    
+    print_import_summary(summary, dry_run)
+    
+    if dry_run and summary['created'] > 0:
+        confirm = input("\nRun the import now? [y/N]: ").strip().lower()
+        if confirm in ['y', 'yes']:
+            summary = import_problems(db_manager, file_path, mapping=mapping, progress=print_progress)
+            print_import_summary(summary, dry_run=False)
+            dry_run = False
+    
+    offer_error_report(summary)
+    
+    if not dry_run:
+        notify_import_finished(db_manager, file_path, summary)
+        settings = offer_settings_restore(file_path)
+        if settings:
+            settings_summary = import_settings(db_manager, settings)
+            print(f"✅ Restored {len(settings_summary['applied'])} setting(s)")
+            for entry in settings_summary['errors']:
+                print(f"  ❌ {entry['key']}: {entry['message']}")
+    
+    input("\nPress Enter to continue...")
+    return not dry_run and summary['created'] > 0
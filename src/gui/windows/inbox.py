@@ -0,0 +1,153 @@
+"""
+Inbox window for DSA Recall GUI.
+
+This window quickly captures problems without scheduling them, and
+promotes them into the review rotation once they have been triaged.
+"""
+
+from src.database.models import Problem, normalize_difficulty
+from src.utils.spaced_repetition import capture_to_inbox, promote_from_inbox
+from src.utils.leetcode import fetch_problem_metadata
+from src.utils.page_title import fetch_page_title
+from src.utils.sources import detect_source
+from src.utils.validation import validate_link, problem_errors
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def quick_capture(db_manager):
+    """
+    Capture a problem into the inbox with just a link and title.
+    
+    Links that are already stored aren't captured again. If the title is
+    left empty it is looked up from the page (LeetCode links also get
+    their difficulty and tags).
+    
+    Args:
+        db_manager: Database manager instance
+        
+    Returns:
+        int: ID of the captured problem, or None if cancelled
+    """
+    link = input("Link: ").strip()
+    error = validate_link(link)
+    if error:
+        print(f"❌ {error}")
+        input("Press Enter to continue...")
+        return None
+    
+    existing = db_manager.find_problem_by_link(link)
+    if existing:
+        print(f"⚠️  Already saved as '{existing.title}' (ID: {existing.id}).")
+        input("Press Enter to continue...")
+        return None
+    
+    title = input("Title (leave empty to look it up): ").strip()
+    metadata = fetch_problem_metadata(link)
+    if not title and link:
+        print("🔎 Looking up the page title...")
+        title = metadata['title'] if metadata else fetch_page_title(link)
+        if title:
+            print(f"✅ Found '{title}'")
+    title = title or link
+    if not title:
+        print("❌ A link or title is required!")
+        input("Press Enter to continue...")
+        return None
+    
+    problem = Problem(title=title, link=link, source=detect_source(link))
+    if metadata:
+        problem.difficulty = normalize_difficulty(metadata['difficulty'])
+        problem.tags = ", ".join(metadata['tags'])
+    errors = problem_errors(problem)
+    if errors:
+        for error in errors.values():
+            print(f"❌ {error}")
+        input("Press Enter to continue...")
+        return None
+    
+    capture_to_inbox(problem)
+    problem_id = db_manager.add_problem(problem)
+    print(f"✅ Captured '{problem.title}' to the inbox.")
+    input("Press Enter to continue...")
+    return problem_id
+
+
+def show_inbox_window(db_manager):
+    """
+    Show the inbox window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("📬 Inbox")
+        print("=" * 30)
+        print("Captured problems are not scheduled until they have tags and an approach.")
+        print()
+        
+        problems = db_manager.get_inbox_problems()
+        
+        if not problems:
+            print("Inbox is empty.")
+        else:
+            print(f"{'ID':<4} {'Title':<30} {'Missing':<30}")
+            print("-" * 66)
+            for problem in problems:
+                title = problem.title[:28] + ".." if len(problem.title) > 30 else problem.title
+                print(f"{problem.id:<4} {title:<30} {', '.join(problem.missing_fields):<30}")
+        
+        print("\nActions:")
+        print("[c] Quick capture")
+        if problems:
+            print("[v<ID>] View/Edit problem (e.g., v1)")
+            print("[p<ID>] Promote to review schedule (e.g., p1)")
+            print("[d<ID>] Move problem to trash (e.g., d1)")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'c':
+                quick_capture(db_manager)
+            elif choice[:1] in ('v', 'p', 'd') and len(choice) > 1:
+                try:
+                    problem = db_manager.get_problem(int(choice[1:]))
+                except ValueError:
+                    print("Invalid problem ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                if not problem:
+                    print("Problem not found!")
+                    input("Press Enter to continue...")
+                elif choice.startswith('v'):
+                    from .problem_card import show_problem_card_window
+                    show_problem_card_window(db_manager, problem)
+                elif choice.startswith('p'):
+                    if promote_from_inbox(problem, db_manager.get_settings()):
+                        db_manager.update_problem(problem)
+                        print(f"✅ '{problem.title}' is scheduled for {problem.next_review}.")
+                    else:
+                        print("❌ Add tags and an approach before promoting this problem.")
+                    input("Press Enter to continue...")
+                else:
+                    confirm = input(f"Move '{problem.title}' to the trash? [y/N]: ").strip().lower()
+                    if confirm in ['y', 'yes']:
+                        db_manager.trash_problem(problem.id)
+                        print(f"✅ Problem '{problem.title}' moved to the trash.")
+                        input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
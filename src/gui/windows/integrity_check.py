@@ -0,0 +1,49 @@
+"""
+Integrity Check window for DSA Recall GUI.
+
+This window reports inconsistent data and offers to repair it.
+"""
+
+from src.utils.integrity import check_integrity
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_integrity_check_window(db_manager):
+    """
+    Show the integrity check window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    clear_screen()
+    
+    print("🩺 Data Integrity Check")
+    print("=" * 30)
+    print()
+    
+    issues = check_integrity(db_manager)
+    
+    if not issues:
+        print("✅ No problems found.")
+        input("\nPress Enter to continue...")
+        return
+    
+    for issue in issues:
+        marker = "⚠️ " if issue['repairable'] else "ℹ️ "
+        print(f"{marker} {issue['message']}")
+    
+    repairable = sum(1 for issue in issues if issue['repairable'])
+    print(f"\nFound {len(issues)} issue(s), {repairable} can be repaired automatically.")
+    
+    if repairable:
+        confirm = input("Repair them now? [y/N]: ").strip().lower()
+        if confirm in ['y', 'yes']:
+            repaired = sum(1 for issue in check_integrity(db_manager, repair=True) if issue['repaired'])
+            print(f"✅ Repaired {repaired} issue(s).")
+    
+    input("\nPress Enter to continue...")
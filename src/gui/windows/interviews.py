@@ -0,0 +1,286 @@
+"""
+Interviews window for DSA Recall GUI.
+
+This window records real interview rounds, links the stored problems
+that came up in them and shows which companies and tags actually appear.
+"""
+
+from datetime import date
+
+from src.config import INTERVIEW_OUTCOMES
+from src.database.models import Interview
+from src.utils.editor import edit_approach
+
+# Number of companies and tags listed in the interview summary
+TOP_ENTRIES = 5
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def ask_outcome(current):
+    """
+    Ask for an interview outcome.
+    
+    Args:
+        current: Current outcome, kept if the input is empty
+        
+    Returns:
+        str: Chosen outcome from INTERVIEW_OUTCOMES
+    """
+    while True:
+        answer = input(f"Outcome ({'/'.join(INTERVIEW_OUTCOMES)}, current: {current}): ").strip()
+        if not answer:
+            return current
+        for outcome in INTERVIEW_OUTCOMES:
+            if outcome.lower() == answer.lower():
+                return outcome
+        print(f"❌ Choose one of: {', '.join(INTERVIEW_OUTCOMES)}")
+
+
+def ask_interview_date(current):
+    """
+    Ask for an interview date.
+    
+    Args:
+        current: Current date, kept if the input is empty
+        
+    Returns:
+        date: Chosen date
+    """
+    while True:
+        answer = input(f"Date (YYYY-MM-DD, current: {current}): ").strip()
+        if not answer:
+            return current
+        try:
+            return date.fromisoformat(answer)
+        except ValueError:
+            print("❌ Invalid date, use the YYYY-MM-DD format.")
+
+
+def print_interview_summary(db_manager):
+    """
+    Print the companies and tags that come up most in interviews.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    stats = db_manager.get_interview_statistics()
+    if not stats['total_interviews']:
+        return
+    
+    outcomes = ", ".join(f"{outcome}: {count}" for outcome, count in stats['by_outcome'].items())
+    print(f"\n{stats['total_interviews']} interview(s) ({outcomes})")
+    
+    companies = list(stats['by_company'].items())[:TOP_ENTRIES]
+    print("Top companies: " + ", ".join(f"{company} ({count})" for company, count in companies))
+    
+    tags = list(stats['by_tag'].items())[:TOP_ENTRIES]
+    if tags:
+        print("Most asked tags: " + ", ".join(f"{tag} ({count})" for tag, count in tags))
+    else:
+        print("Most asked tags: link problems to interviews to see them here")
+
+
+def show_interviews_window(db_manager):
+    """
+    Show the interview log window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("🎤 Interviews")
+        print("=" * 30)
+        print()
+        
+        interviews = db_manager.get_all_interviews()
+        
+        if not interviews:
+            print("No interviews recorded yet.")
+        else:
+            print(f"{'ID':<4} {'Date':<12} {'Company':<20} {'Round':<18} {'Outcome':<8}")
+            print("-" * 66)
+            
+            for interview in interviews:
+                company = interview.company[:18] + ".." if len(interview.company) > 20 else interview.company
+                round_name = interview.round_name[:16] + ".." if len(interview.round_name) > 18 else interview.round_name
+                print(f"{interview.id:<4} {str(interview.interview_date or '-'):<12} {company:<20} "
+                      f"{round_name or '-':<18} {interview.outcome:<8}")
+            
+            print_interview_summary(db_manager)
+        
+        print("\nActions:")
+        print("[n] New interview")
+        if interviews:
+            print("[v<ID>] View/Edit interview (e.g., v1)")
+            print("[d<ID>] Delete interview (e.g., d1)")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                interview = Interview()
+                company = input("Company (required): ").strip()
+                if not company:
+                    print("❌ Company is required!")
+                    input("Press Enter to continue...")
+                    continue
+                interview.company = company
+                interview.interview_date = ask_interview_date(date.today())
+                interview.round_name = input("Round (e.g., Phone screen, optional): ").strip()
+                interview.outcome = ask_outcome(interview.outcome)
+                interview_id = db_manager.add_interview(interview)
+                show_interview_window(db_manager, db_manager.get_interview(interview_id))
+            elif choice.startswith(('v', 'd')):
+                try:
+                    interview = db_manager.get_interview(int(choice[1:]))
+                except (ValueError, IndexError):
+                    print("Invalid interview ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                if not interview:
+                    print("Interview not found!")
+                    input("Press Enter to continue...")
+                elif choice[0] == 'v':
+                    show_interview_window(db_manager, interview)
+                else:
+                    confirm = input(f"Are you sure you want to delete the {interview.company} interview? [y/N]: ").strip().lower()
+                    if confirm in ['y', 'yes']:
+                        db_manager.delete_interview(interview.id)
+                        print("✅ Interview deleted successfully.")
+                        input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
+
+
+def show_interview_window(db_manager, interview):
+    """
+    Show a single interview with edit options.
+    
+    Linking and unlinking problems takes effect immediately; the other
+    fields are stored when saved.
+    
+    Args:
+        db_manager: Database manager instance
+        interview: Interview instance to display
+    """
+    while True:
+        clear_screen()
+        
+        print(f"Interview: {interview.company}")
+        print("=" * 60)
+        print()
+        
+        print(f"Company: {interview.company}")
+        print(f"Date: {interview.interview_date or '(not set)'}")
+        print(f"Round: {interview.round_name or '(not set)'}")
+        print(f"Outcome: {interview.outcome}")
+        print()
+        print(interview.notes.strip() or "(no notes)")
+        print()
+        
+        problems = db_manager.get_interview_problems(interview.id)
+        print("Problems asked:")
+        if not problems:
+            print("  (none linked)")
+        for problem in problems:
+            tags = f" [{', '.join(problem.tag_list)}]" if problem.tag_list else ""
+            print(f"  {problem.id}. {problem.title}{tags}")
+        print()
+        
+        print("Actions:")
+        print("[c] Edit company")
+        print("[t] Edit date")
+        print("[r] Edit round")
+        print("[o] Edit outcome")
+        print("[e] Edit notes (external editor)")
+        print("[p] Link a problem")
+        if problems:
+            print("[u<ID>] Unlink problem (e.g., u3)")
+        print("[s] Save changes")
+        print("[b] Back to interviews")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'c':
+                company = input(f"Enter company (current: {interview.company}): ").strip()
+                if company:
+                    interview.company = company
+                    print("✅ Company updated!")
+                else:
+                    print("❌ Company cannot be empty!")
+                input("Press Enter to continue...")
+            elif choice == 't':
+                interview.interview_date = ask_interview_date(interview.interview_date)
+                print("✅ Date updated!")
+                input("Press Enter to continue...")
+            elif choice == 'r':
+                interview.round_name = input(f"Enter round (current: {interview.round_name or '(not set)'}): ").strip()
+                print("✅ Round updated!")
+                input("Press Enter to continue...")
+            elif choice == 'o':
+                interview.outcome = ask_outcome(interview.outcome)
+                print("✅ Outcome updated!")
+                input("Press Enter to continue...")
+            elif choice == 'e':
+                try:
+                    edited_notes = edit_approach(interview.notes)
+                    if edited_notes is not None:
+                        interview.notes = edited_notes
+                        print("✅ Notes updated!")
+                    else:
+                        print("⚠️  Editing cancelled")
+                except Exception as e:
+                    print(f"❌ Failed to open editor: {str(e)}")
+                input("Press Enter to continue...")
+            elif choice == 'p':
+                try:
+                    problem = db_manager.get_problem(int(input("Problem ID to link: ").strip()))
+                    if not problem:
+                        print("Problem not found!")
+                    elif db_manager.link_interview_problem(interview.id, problem.id):
+                        print(f"✅ Linked '{problem.title}'!")
+                    else:
+                        print("⚠️  That problem is already linked.")
+                except ValueError:
+                    print("Invalid problem ID!")
+                input("Press Enter to continue...")
+            elif choice.startswith('u') and len(choice) > 1:
+                try:
+                    if db_manager.unlink_interview_problem(interview.id, int(choice[1:])):
+                        print("✅ Problem unlinked!")
+                    else:
+                        print("That problem isn't linked to this interview.")
+                except ValueError:
+                    print("Invalid problem ID!")
+                input("Press Enter to continue...")
+            elif choice == 's':
+                try:
+                    db_manager.update_interview(interview)
+                    print("✅ Interview saved successfully!")
+                except Exception as e:
+                    print(f"❌ Failed to save interview: {str(e)}")
+                input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
@@ -0,0 +1,134 @@
+"""
+Journal window for DSA Recall GUI.
+
+This window lists a problem's timestamped journal entries and lets users
+add, edit and delete them.
+"""
+
+from src.config import JOURNAL_TIMESTAMP_FORMAT
+from src.database.models import JournalEntry
+from src.utils.editor import edit_approach
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def ask_journal_text(current=""):
+    """
+    Ask for the text of a journal entry.
+    
+    A single line can be typed directly; leaving the prompt empty opens
+    the external editor for longer entries.
+    
+    Args:
+        current: Existing text to edit
+        
+    Returns:
+        str: Entry text, or None if nothing was entered
+    """
+    text = input("Entry (leave empty to open the editor): ").strip()
+    if not text:
+        try:
+            text = (edit_approach(current) or "").strip()
+        except Exception as e:
+            print(f"❌ Failed to open editor: {str(e)}")
+            return None
+    return text or None
+
+
+def add_review_journal_entry(db_manager, problem):
+    """
+    Offer to write a journal entry right after a review.
+    
+    Args:
+        db_manager: Database manager instance
+        problem: Problem that was just reviewed
+    """
+    text = input("Journal: what went wrong or right this time? (optional): ").strip()
+    if text:
+        db_manager.add_journal_entry(JournalEntry(problem_id=problem.id, body=text))
+        print("📝 Journal entry saved.")
+
+
+def show_journal_window(db_manager, problem):
+    """
+    Show the journal for a problem.
+    
+    Args:
+        db_manager: Database manager instance
+        problem: Problem instance whose journal is shown
+    """
+    while True:
+        clear_screen()
+        
+        print(f"📝 Journal: {problem.title}")
+        print("=" * 60)
+        print()
+        
+        entries = db_manager.get_journal_entries(problem.id)
+        
+        if not entries:
+            print("No journal entries yet.")
+        else:
+            for entry in entries:
+                timestamp = entry.created_at.strftime(JOURNAL_TIMESTAMP_FORMAT) if entry.created_at else "-"
+                print(f"#{entry.id}  {timestamp}")
+                for line in entry.body.strip().splitlines():
+                    print(f"    {line}")
+                print()
+        
+        print("Actions:")
+        print("[n] New entry")
+        if entries:
+            print("[e<ID>] Edit entry (e.g., e1)")
+            print("[d<ID>] Delete entry (e.g., d1)")
+        print("[b] Back to problem card")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                text = ask_journal_text()
+                if text:
+                    db_manager.add_journal_entry(JournalEntry(problem_id=problem.id, body=text))
+                    print("✅ Entry added!")
+                else:
+                    print("⚠️  Nothing entered, entry not saved.")
+                input("Press Enter to continue...")
+            elif choice.startswith(('e', 'd')):
+                try:
+                    entry = db_manager.get_journal_entry(int(choice[1:]))
+                except (ValueError, IndexError):
+                    print("Invalid entry ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                if not entry or entry.problem_id != problem.id:
+                    print("Entry not found!")
+                    input("Press Enter to continue...")
+                elif choice[0] == 'e':
+                    text = ask_journal_text(entry.body)
+                    if text:
+                        entry.body = text
+                        db_manager.update_journal_entry(entry)
+                        print("✅ Entry updated!")
+                    else:
+                        print("⚠️  Entry unchanged.")
+                    input("Press Enter to continue...")
+                else:
+                    confirm = input(f"Are you sure you want to delete entry {entry.id}? [y/N]: ").strip().lower()
+                    if confirm in ['y', 'yes']:
+                        db_manager.delete_journal_entry(entry.id)
+                        print("✅ Entry deleted successfully.")
+                        input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
@@ -7,7 +7,8 @@ This window shows the main dashboard with due problems and navigation buttons.
 import webbrowser
 from datetime import date
 
-from src.config import MAIN_MENU_OPTIONS
+from src.config import MAIN_MENU_OPTIONS, REVIEW_BUCKETS, STATUS_INBOX
+from src.utils.spaced_repetition import get_mastery_candidates
 
 def clear_screen():
     """Clear the screen for a cleaner interface."""
@@ -34,24 +35,56 @@ def show_main_dashboard(db_manager):
         print()
         
         # Get due problems
-        due_problems = db_manager.get_due_problems()
+        due_problems, overflow = db_manager.get_review_queue()
         
         print("📅 Problems Due Today:")
         print("-" * 30)
         
-        if not due_problems:
+        if not due_problems and overflow:
+            print("✅ Daily review limit reached!")
+        elif not due_problems:
             print("🎉 No problems due for review today!")
             print("Come back tomorrow or add new problems.")
         else:
             for i, problem in enumerate(due_problems, 1):
                 print(f"{i}. {problem.title} (Streak: {problem.streak_level})")
+        if overflow:
+            print(f"+{overflow} more due, over the daily review limit")
+        
+        due_solutions = db_manager.get_due_solutions()
+        if due_solutions:
+            print("\n🧩 Language Tracks Due (review them from the problem card's solutions):")
+            for solution in due_solutions:
+                track_problem = db_manager.get_problem(solution.problem_id)
+                print(f"- {track_problem.title} [{solution.language or 'no language'}] (problem {track_problem.id})")
+        
+        bucket_counts = db_manager.get_review_bucket_counts()
+        print()
+        print(" | ".join(f"{label}: {bucket_counts[bucket]}" for bucket, label in REVIEW_BUCKETS.items()))
         
         print("\n" + "=" * 50)
         print("Navigation Options:")
         print("[v<ID>] View Problem (e.g., v1)")
         print("[a] ➕ Add Problem")
+        inbox_count = db_manager.get_status_counts().get(STATUS_INBOX, 0)
+        print(f"[c] 📬 Inbox ({inbox_count})")
         print("[b] 📖 View All Problems") 
+        print("[p] ⏱️  Focus Session")
+        print("[w] 🗂️  Batch Review")
+        print("[i] 📥 Import Problems")
+        print("[x] 📤 Export Data")
+        print("[n] 🗒️  Notes")
+        print("[f] 🔍 Search")
         print("[s] 🔥 View Streak Tracker")
+        print("[t] 📊 Statistics")
+        print("[r] 🎤 Interviews")
+        print("[l] 📚 Collections")
+        mastery_count = len(get_mastery_candidates(db_manager.get_all_problems(include_archived=False)))
+        print(f"[g] 🎓 Mastery Suggestions ({mastery_count})" if mastery_count else "[g] 🎓 Mastery Suggestions")
+        unread_count = db_manager.count_unread_notifications()
+        print(f"[m] 🔔 Notifications ({unread_count} unread)" if unread_count else "[m] 🔔 Notifications")
+        print("[d] 🗑️  Trash")
+        print("[o] ⚙️  Settings")
         print("[q] 🚪 Exit")
         print()
         
@@ -62,10 +95,38 @@ def show_main_dashboard(db_manager):
                 return 'exit'
             elif choice == 'a':
                 return 'add_problem'
+            elif choice == 'c':
+                return 'inbox'
             elif choice == 'b':
                 return 'all_problems'
+            elif choice == 'i':
+                return 'import_problems'
+            elif choice == 'x':
+                return 'export_data'
+            elif choice == 'n':
+                return 'notes'
+            elif choice == 'f':
+                return 'search'
             elif choice == 's':
                 return 'streak_tracker'
+            elif choice == 't':
+                return 'statistics'
+            elif choice == 'm':
+                return 'notifications'
+            elif choice == 'r':
+                return 'interviews'
+            elif choice == 'l':
+                return 'collections'
+            elif choice == 'g':
+                return 'mastery'
+            elif choice == 'p':
+                return 'focus_session'
+            elif choice == 'w':
+                return 'batch_review'
+            elif choice == 'd':
+                return 'trash'
+            elif choice == 'o':
+                return 'settings'
             elif choice.startswith('v') and len(choice) > 1:
                 # View problem
                 try:
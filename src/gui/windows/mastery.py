@@ -0,0 +1,92 @@
+"""
+Mastery Suggestions window for DSA Recall GUI.
+
+This window lists problems that have been easy for a long time so they
+can be archived as mastered, freeing review time for harder ones.
+"""
+
+from src.config import MASTERY_EASY_REVIEWS, MASTERY_MIN_INTERVAL_DAYS
+from src.utils.spaced_repetition import archive_problem, get_mastery_candidates
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_mastery_window(db_manager):
+    """
+    Show problems suggested for archiving as mastered.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("🎓 Mastery Suggestions")
+        print("=" * 30)
+        print()
+        
+        candidates = get_mastery_candidates(db_manager.get_all_problems(include_archived=False))
+        
+        if not candidates:
+            print(f"No suggestions. Problems show up here after {MASTERY_EASY_REVIEWS} Easy reviews in a row")
+            print(f"with an interval of at least {MASTERY_MIN_INTERVAL_DAYS} days.")
+            input("Press Enter to continue...")
+            return
+        
+        print(f"{'ID':<4} {'Title':<30} {'Diff':<6} {'Streak':<6} {'Interval':<10}")
+        print("-" * 60)
+        
+        for problem in candidates:
+            title = problem.title[:28] + ".." if len(problem.title) > 30 else problem.title
+            interval = f"{(problem.next_review - problem.last_marked).days} days"
+            print(f"{problem.id:<4} {title:<30} {problem.difficulty or '-':<6} {problem.streak_level:<6} {interval:<10}")
+        
+        print("\nActions:")
+        print("[x<ID>] Archive as mastered (e.g., x1)")
+        print("[a] Archive all suggested problems")
+        print("[v<ID>] View/Edit problem (e.g., v1)")
+        print("[b] Back")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'a':
+                confirm = input(f"Archive all {len(candidates)} suggested problem(s)? [y/N]: ").strip().lower()
+                if confirm in ['y', 'yes']:
+                    for problem in candidates:
+                        archive_problem(problem)
+                        db_manager.update_problem(problem)
+                    print(f"🗄️  Archived {len(candidates)} problem(s).")
+                    input("Press Enter to continue...")
+            elif choice.startswith(('x', 'v')):
+                try:
+                    problem_id = int(choice[1:])
+                except (ValueError, IndexError):
+                    print("Invalid problem ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                problem = next((candidate for candidate in candidates if candidate.id == problem_id), None)
+                if not problem:
+                    print("That problem isn't in the suggestions!")
+                    input("Press Enter to continue...")
+                elif choice[0] == 'x':
+                    archive_problem(problem)
+                    db_manager.update_problem(problem)
+                    print(f"🗄️  Archived '{problem.title}'. It won't come up for review until unarchived.")
+                    input("Press Enter to continue...")
+                else:
+                    from .problem_card import show_problem_card_window
+                    show_problem_card_window(db_manager, problem)
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
@@ -0,0 +1,70 @@
+"""
+Needs Attention window for DSA Recall GUI.
+
+This window lists problems with missing metadata so they can be
+cleaned up one after another.
+"""
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_needs_attention_window(db_manager):
+    """
+    Show problems that are missing tags, difficulty, approach or code.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("🧹 Problems Needing Attention")
+        print("=" * 30)
+        print()
+        
+        problems = db_manager.get_problems_needing_attention()
+        
+        if not problems:
+            print("🎉 Every problem has tags, a difficulty, an approach and code!")
+            input("Press Enter to continue...")
+            return
+        
+        print(f"{'ID':<4} {'Title':<30} {'Missing':<30}")
+        print("-" * 66)
+        
+        for problem in problems:
+            title = problem.title[:28] + ".." if len(problem.title) > 30 else problem.title
+            print(f"{problem.id:<4} {title:<30} {', '.join(problem.missing_fields):<30}")
+        
+        print(f"\n{len(problems)} problem{'s' if len(problems) != 1 else ''} to clean up")
+        print("\nActions:")
+        print("[v<ID>] View/Edit problem (e.g., v1)")
+        print("[b] Back")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice.startswith('v'):
+                try:
+                    problem = db_manager.get_problem(int(choice[1:]))
+                    if problem:
+                        from .problem_card import show_problem_card_window
+                        show_problem_card_window(db_manager, problem)
+                    else:
+                        print("Problem not found!")
+                        input("Press Enter to continue...")
+                except (ValueError, IndexError):
+                    print("Invalid problem ID!")
+                    input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
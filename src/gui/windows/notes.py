@@ -0,0 +1,192 @@
+"""
+Notes window for DSA Recall GUI.
+
+This window lists general study notes and lets users create, edit,
+link and delete them.
+"""
+
+from src.database.models import Note
+from src.utils.editor import edit_approach
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_notes_window(db_manager):
+    """
+    Show the notes browser window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("🗒️  Notes")
+        print("=" * 30)
+        print()
+        
+        notes = db_manager.get_all_notes()
+        
+        if not notes:
+            print("No notes yet.")
+        else:
+            print(f"{'ID':<4} {'Title':<30} {'Tags':<20} {'Problem':<8}")
+            print("-" * 66)
+            
+            for note in notes:
+                title = note.title[:28] + ".." if len(note.title) > 30 else note.title
+                tags = note.tags[:18] + ".." if len(note.tags) > 20 else note.tags
+                problem = str(note.problem_id) if note.problem_id else "-"
+                print(f"{note.id:<4} {title:<30} {tags:<20} {problem:<8}")
+        
+        print("\nActions:")
+        print("[n] New note")
+        if notes:
+            print("[v<ID>] View/Edit note (e.g., v1)")
+            print("[d<ID>] Delete note (e.g., d1)")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                note = Note()
+                title = input("Title (required): ").strip()
+                if not title:
+                    print("❌ Title is required!")
+                    input("Press Enter to continue...")
+                    continue
+                note.title = title
+                note.tags = input("Tags (comma-separated, optional): ").strip()
+                note_id = db_manager.add_note(note)
+                show_note_window(db_manager, db_manager.get_note(note_id))
+            elif choice.startswith('v'):
+                try:
+                    note = db_manager.get_note(int(choice[1:]))
+                    if note:
+                        show_note_window(db_manager, note)
+                    else:
+                        print("Note not found!")
+                        input("Press Enter to continue...")
+                except (ValueError, IndexError):
+                    print("Invalid note ID!")
+                    input("Press Enter to continue...")
+            elif choice.startswith('d'):
+                try:
+                    note = db_manager.get_note(int(choice[1:]))
+                    if note:
+                        confirm = input(f"Are you sure you want to delete '{note.title}'? [y/N]: ").strip().lower()
+                        if confirm in ['y', 'yes']:
+                            db_manager.delete_note(note.id)
+                            print(f"✅ Note '{note.title}' deleted successfully.")
+                            input("Press Enter to continue...")
+                    else:
+                        print("Note not found!")
+                        input("Press Enter to continue...")
+                except (ValueError, IndexError):
+                    print("Invalid note ID!")
+                    input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
+
+
+def show_note_window(db_manager, note):
+    """
+    Show a single note with edit options.
+    
+    Args:
+        db_manager: Database manager instance
+        note: Note instance to display
+    """
+    while True:
+        clear_screen()
+        
+        print(f"Note: {note.title}")
+        print("=" * 60)
+        print()
+        
+        linked_problem = db_manager.get_problem(note.problem_id) if note.problem_id else None
+        
+        print(f"Title: {note.title}")
+        print(f"Tags: {', '.join(note.tag_list) or '(none)'}")
+        print(f"Linked Problem: {linked_problem.title if linked_problem else '(none)'}")
+        print(f"Updated: {note.updated_at or 'Never'}")
+        print()
+        print(note.body.strip() or "(empty)")
+        print()
+        
+        print("Actions:")
+        print("[e] Edit body (external editor)")
+        print("[t] Edit title")
+        print("[g] Edit tags")
+        print("[p] Link to problem")
+        print("[s] Save changes")
+        print("[b] Back to notes")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'e':
+                try:
+                    edited_body = edit_approach(note.body)
+                    if edited_body is not None:
+                        note.body = edited_body
+                        print("✅ Body updated!")
+                    else:
+                        print("⚠️  Editing cancelled")
+                except Exception as e:
+                    print(f"❌ Failed to open editor: {str(e)}")
+                input("Press Enter to continue...")
+            elif choice == 't':
+                new_title = input(f"Enter new title (current: {note.title}): ").strip()
+                if new_title:
+                    note.title = new_title
+                    print("✅ Title updated!")
+                else:
+                    print("❌ Title cannot be empty!")
+                input("Press Enter to continue...")
+            elif choice == 'g':
+                note.tags = input(f"Enter tags (current: {note.tags or '(none)'}): ").strip()
+                print("✅ Tags updated!")
+                input("Press Enter to continue...")
+            elif choice == 'p':
+                problem_input = input("Problem ID to link (leave empty to unlink): ").strip()
+                if not problem_input:
+                    note.problem_id = None
+                    print("✅ Note unlinked!")
+                else:
+                    try:
+                        problem = db_manager.get_problem(int(problem_input))
+                        if problem:
+                            note.problem_id = problem.id
+                            print(f"✅ Linked to '{problem.title}'!")
+                        else:
+                            print("Problem not found!")
+                    except ValueError:
+                        print("Invalid problem ID!")
+                input("Press Enter to continue...")
+            elif choice == 's':
+                try:
+                    db_manager.update_note(note)
+                    print("✅ Note saved successfully!")
+                except Exception as e:
+                    print(f"❌ Failed to save note: {str(e)}")
+                input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
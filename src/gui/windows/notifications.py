@@ -0,0 +1,63 @@
+"""
+Notifications window for DSA Recall GUI.
+
+This window lists in-app notifications and lets users mark them as read.
+"""
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_notifications_window(db_manager):
+    """
+    Show the notifications window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("🔔 Notifications")
+        print("=" * 30)
+        print()
+        
+        notifications = db_manager.get_notifications()
+        
+        if not notifications:
+            print("No notifications yet.")
+        else:
+            for notification in notifications:
+                marker = "  " if notification.is_read else "🆕"
+                print(f"{marker} {notification.id:<4} {notification.created_at}  {notification.message}")
+        
+        print("\nActions:")
+        if any(not notification.is_read for notification in notifications):
+            print("[r<ID>] Mark as read (e.g., r1)")
+            print("[a] Mark all as read")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'a':
+                db_manager.mark_notification_read()
+            elif choice.startswith('r'):
+                try:
+                    if not db_manager.mark_notification_read(int(choice[1:])):
+                        print("Unread notification not found!")
+                        input("Press Enter to continue...")
+                except (ValueError, IndexError):
+                    print("Invalid notification ID!")
+                    input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
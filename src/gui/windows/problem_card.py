@@ -5,9 +5,32 @@ This window shows a detailed card view of a problem with edit capabilities.
 """
 
 import webbrowser
+from datetime import date, timedelta
 
-from src.utils.spaced_repetition import mark_problem_easy, mark_problem_hard, reset_problem_streak
+from src.config import DIFFICULTY_LEVELS, STATUS_ACTIVE, STATUS_INBOX, STATUS_ARCHIVED, KNOWN_COMPANIES
+from src.database.models import normalize_difficulty
+
+from src.utils.spaced_repetition import (
+    reset_problem_streak, archive_problem, unarchive_problem,
+    snooze_problem, get_difficulty_trend, is_mastery_candidate
+)
+from src.scheduler.factory import get_scheduler
 from src.utils.editor import edit_approach, edit_code
+from src.utils.tagging import suggest_tags
+from src.utils.sources import detect_source, normalize_source, normalize_companies
+from src.utils.validation import validate_title, validate_link
+from src.utils.notifications import notify_if_streak_milestone, notify_if_leech, notify_review_completed
+from src.gui.windows.solutions import show_solutions_window
+from src.gui.windows.journal import show_journal_window, add_review_journal_entry
+from src.gui.windows.attachments import show_attachments_window
+
+
+# How each difficulty trend is shown
+TREND_LABELS = {
+    'easier': "📈 Getting easier",
+    'harder': "📉 Getting harder",
+    'steady': "➡️  Steady",
+}
 
 
 def clear_screen():
@@ -30,25 +53,72 @@ def show_problem_card_window(db_manager, problem):
     while True:
         clear_screen()
         
+        # Inbox and archived problems aren't scheduled, so review actions don't apply
+        schedulable = problem.status == STATUS_ACTIVE
+        
         print(f"Problem Card: {problem.title}")
         print("=" * 60)
         print()
         
         print(f"Title: {problem.title}")
         print(f"Link: {problem.link or '(not set)'}")
+        print(f"Tags: {', '.join(problem.tag_list) or '(none)'}")
+        print(f"Difficulty: {problem.difficulty or '(not set)'}")
+        print(f"Source: {problem.source or '(not set)'}")
+        print(f"Companies: {', '.join(problem.company_list) or '(none)'}")
+        if problem.status == STATUS_INBOX:
+            print("Status: 📬 In inbox (promote it from the inbox to schedule reviews)")
+        elif problem.status == STATUS_ARCHIVED:
+            print("Status: 🗄️  Archived (unarchive it to review it again)")
         print(f"Streak Level: {problem.streak_level}")
         print(f"Next Review: {problem.next_review or 'Not set'}")
         print(f"Last Marked: {problem.last_marked or 'Never'}")
+        
+        trend = get_difficulty_trend(problem)
+        if trend:
+            print(f"Trend: {TREND_LABELS[trend]}")
+        if is_mastery_candidate(problem):
+            print("💡 Easy every time lately with long intervals. Consider archiving it as mastered ([x]).")
+        
+        linked_notes = db_manager.get_notes_for_problem(problem.id) if problem.id else []
+        if linked_notes:
+            print(f"Notes: {', '.join(note.title for note in linked_notes)}")
+        
+        collections = db_manager.get_problem_collections(problem.id) if problem.id else []
+        if collections:
+            print(f"Collections: {', '.join(collection.name for collection in collections)}")
+        
+        solutions = db_manager.get_solutions(problem.id) if problem.id else []
+        if solutions:
+            languages = sorted({solution.language for solution in solutions if solution.language})
+            print(f"Solutions: {len(solutions)} ({', '.join(languages) or 'no language set'})")
         print()
         
         print("Actions:")
-        print("[e] Mark as Easy ✅")
-        print("[h] Mark as Hard ❌")
+        if schedulable:
+            print("[e] Mark as Easy ✅")
+            print("[h] Mark as Hard ❌")
         print("[a] View/Edit Approach (external editor)")
         print("[c] View/Edit Code (external editor)")
         print("[t] Edit title")
         print("[l] Edit link")
-        print("[r] Review Today (reset streak)")
+        print("[g] Edit tags")
+        print("[d] Edit difficulty")
+        print("[i] Edit source")
+        print("[k] Edit companies")
+        print("[u] Suggest tags")
+        print("[m] Manage solutions")
+        journal_count = len(db_manager.get_journal_entries(problem.id)) if problem.id else 0
+        print(f"[j] Journal ({journal_count})")
+        if problem.id:
+            print(f"[f] Attachments ({len(db_manager.get_attachments(problem.id))})")
+        if schedulable:
+            print("[r] Review Today (reset streak)")
+            print("[z] Snooze (postpone next review)")
+        if problem.status == STATUS_ACTIVE:
+            print("[x] Archive (remove from review rotation)")
+        elif problem.status == STATUS_ARCHIVED:
+            print("[x] Unarchive (back into review rotation)")
         if problem.link:
             print("[o] Open link in browser")
         print("[s] Save changes")
@@ -59,18 +129,41 @@ def show_problem_card_window(db_manager, problem):
             
             if choice == 'b':
                 break
-            elif choice == 'e':
-                mark_problem_easy(problem)
+            elif choice in ('e', 'h', 'r', 'z') and not schedulable:
+                if problem.status == STATUS_ARCHIVED:
+                    print("❌ Unarchive this problem before reviewing it.")
+                else:
+                    print("❌ Promote this problem from the inbox before reviewing it.")
+                input("Press Enter to continue...")
+            elif choice == 'x' and problem.status == STATUS_ACTIVE:
+                archive_problem(problem)
                 db_manager.update_problem(problem)
-                db_manager.record_daily_review()
+                print(f"🗄️  Archived '{problem.title}'. It won't come up for review until unarchived.")
+                input("Press Enter to continue...")
+            elif choice == 'x' and problem.status == STATUS_ARCHIVED:
+                unarchive_problem(problem)
+                db_manager.update_problem(problem)
+                print(f"✅ Unarchived '{problem.title}'. Next review: {problem.next_review}")
+                input("Press Enter to continue...")
+            elif choice == 'e':
+                settings = db_manager.get_settings()
+                get_scheduler(settings).mark_easy(problem, settings)
+                db_manager.save_review(problem, 'easy')
+                notify_if_streak_milestone(db_manager)
+                notify_review_completed(db_manager, problem, 'easy')
                 print(f"✅ Marked '{problem.title}' as Easy!")
+                add_review_journal_entry(db_manager, problem)
                 input("Press Enter to continue...")
                 return True
             elif choice == 'h':
-                mark_problem_hard(problem)
-                db_manager.update_problem(problem)
-                db_manager.record_daily_review()
+                settings = db_manager.get_settings()
+                get_scheduler(settings).mark_hard(problem, settings)
+                db_manager.save_review(problem, 'hard')
+                notify_if_streak_milestone(db_manager)
+                notify_if_leech(db_manager, problem)
+                notify_review_completed(db_manager, problem, 'hard')
                 print(f"❌ Marked '{problem.title}' as Hard!")
+                add_review_journal_entry(db_manager, problem)
                 input("Press Enter to continue...")
                 return True
             elif choice == 'a':
@@ -97,16 +190,86 @@ def show_problem_card_window(db_manager, problem):
                 input("Press Enter to continue...")
             elif choice == 't':
                 new_title = input(f"Enter new title (current: {problem.title}): ").strip()
-                if new_title:
+                error = validate_title(new_title)
+                if not error:
                     problem.title = new_title
                     print("✅ Title updated!")
                 else:
-                    print("❌ Title cannot be empty!")
+                    print(f"❌ {error}")
                 input("Press Enter to continue...")
             elif choice == 'l':
                 new_link = input(f"Enter new link (current: {problem.link or '(not set)'}): ").strip()
-                problem.link = new_link
-                print("✅ Link updated!")
+                error = validate_link(new_link)
+                if not error:
+                    problem.link = new_link
+                    print("✅ Link updated!")
+                else:
+                    print(f"❌ {error}")
+                input("Press Enter to continue...")
+            elif choice == 'g':
+                problem.tags = input(f"Enter tags (current: {problem.tags or '(none)'}): ").strip()
+                print("✅ Tags updated!")
+                input("Press Enter to continue...")
+            elif choice == 'd':
+                difficulty_input = input(f"Enter difficulty ({'/'.join(DIFFICULTY_LEVELS)}, current: {problem.difficulty or '(not set)'}): ").strip()
+                difficulty = normalize_difficulty(difficulty_input)
+                if difficulty:
+                    problem.difficulty = difficulty
+                    print("✅ Difficulty updated!")
+                else:
+                    print(f"❌ Difficulty must be one of: {', '.join(DIFFICULTY_LEVELS)}")
+                input("Press Enter to continue...")
+            elif choice == 'i':
+                detected = detect_source(problem.link)
+                hint = f", leave empty for {detected}" if detected else ""
+                source_input = input(f"Enter source (current: {problem.source or '(not set)'}{hint}): ")
+                problem.source = normalize_source(source_input) or detected
+                print("✅ Source updated!")
+                input("Press Enter to continue...")
+            elif choice == 'k':
+                print(f"Common companies: {', '.join(KNOWN_COMPANIES)}")
+                problem.companies = normalize_companies(
+                    input(f"Enter companies (current: {problem.companies or '(none)'}): ")
+                )
+                print("✅ Companies updated!")
+                input("Press Enter to continue...")
+            elif choice == 'u':
+                suggested = suggest_tags(problem)
+                if not suggested:
+                    print("No tag suggestions for this problem.")
+                else:
+                    print(f"Suggested tags: {', '.join(suggested)}")
+                    if input("Add these tags? [y/N]: ").strip().lower() in ['y', 'yes']:
+                        problem.tags = ", ".join(problem.tag_list + suggested)
+                        print("✅ Tags updated! Remember to save.")
+                input("Press Enter to continue...")
+            elif choice == 'j':
+                show_journal_window(db_manager, problem)
+            elif choice == 'f' and problem.id:
+                show_attachments_window(db_manager, problem)
+            elif choice == 'm':
+                if show_solutions_window(db_manager, problem):
+                    # The primary solution was rewritten into the stored problem
+                    stored = db_manager.get_problem(problem.id)
+                    problem.approach = stored.approach
+                    problem.code = stored.code
+            elif choice == 'z':
+                snooze_input = input("Snooze for how many days, or until which date (YYYY-MM-DD)? ").strip()
+                try:
+                    if snooze_input.isdigit():
+                        until = date.today() + timedelta(days=int(snooze_input))
+                    else:
+                        until = date.fromisoformat(snooze_input)
+                except ValueError:
+                    until = None
+                    print("❌ Enter a number of days or a date like 2024-01-31.")
+                if until:
+                    try:
+                        snooze_problem(problem, until)
+                        db_manager.update_problem(problem)
+                        print(f"😴 Snoozed '{problem.title}' until {problem.next_review}.")
+                    except ValueError as e:
+                        print(f"❌ Cannot snooze: {str(e)}")
                 input("Press Enter to continue...")
             elif choice == 'r':
                 reset_problem_streak(problem)
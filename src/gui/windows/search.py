@@ -0,0 +1,104 @@
+"""
+Search window for DSA Recall GUI.
+
+This window searches problems, notes and tags from a single prompt.
+"""
+
+from src.utils.search import global_search
+
+# Labels shown for each result type
+RESULT_LABELS = {
+    'problem': "📚 Problem",
+    'note': "🗒️  Note",
+    'tag': "🏷️  Tag",
+}
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_search_window(db_manager):
+    """
+    Show the global search window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    query = ""
+    
+    while True:
+        clear_screen()
+        
+        print("🔍 Search")
+        print("=" * 30)
+        print()
+        
+        if not query:
+            try:
+                query = input("Search for (leave empty to go back): ").strip()
+            except KeyboardInterrupt:
+                break
+            if not query:
+                break
+            continue
+        
+        results = global_search(db_manager, query)
+        
+        print(f"Results for '{query}':")
+        print("-" * 50)
+        
+        if not results:
+            print("No matches found.")
+        else:
+            for i, result in enumerate(results, 1):
+                label = RESULT_LABELS.get(result['type'], result['type'])
+                suffix = ""
+                if result['type'] == 'tag':
+                    suffix = f" ({result['count']} item{'s' if result['count'] != 1 else ''})"
+                print(f"{i:<3} {label:<12} {result['title']}{suffix}")
+        
+        print("\nActions:")
+        if results:
+            print("[v<N>] Open result (e.g., v1)")
+        print("[n] New search")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                query = ""
+            elif choice.startswith('v') and len(choice) > 1:
+                try:
+                    result_index = int(choice[1:]) - 1
+                    if not 0 <= result_index < len(results):
+                        raise IndexError
+                    result = results[result_index]
+                    
+                    if result['type'] == 'problem':
+                        from .problem_card import show_problem_card_window
+                        problem = db_manager.get_problem(result['id'])
+                        if problem:
+                            show_problem_card_window(db_manager, problem)
+                    elif result['type'] == 'note':
+                        from .notes import show_note_window
+                        note = db_manager.get_note(result['id'])
+                        if note:
+                            show_note_window(db_manager, note)
+                    else:
+                        # Narrow the search down to the selected tag
+                        query = result['title']
+                except (ValueError, IndexError):
+                    print("Invalid result number!")
+                    input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
@@ -0,0 +1,113 @@
+"""
+Settings window for DSA Recall GUI.
+
+This window shows the user's preferences and lets them be changed.
+"""
+
+from src.config import DEFAULT_SETTINGS, SETTING_LABELS, SETTING_CHOICES
+from src.scheduler.fsrs import load_weights
+from src.scheduler.optimizer import optimize_weights
+from src.utils.spaced_repetition import parse_setting_value
+from src.gui.windows.integrity_check import show_integrity_check_window
+from src.gui.windows.webhooks import show_webhooks_window
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def optimize_fsrs_weights(db_manager):
+    """
+    Fit the FSRS parameters to the review history and offer to save them.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    print("⏳ Optimizing FSRS parameters from your review history...")
+    try:
+        result = optimize_weights(db_manager.get_all_problems(), load_weights(db_manager.get_settings()))
+    except ValueError as e:
+        print(f"❌ {str(e)}")
+        input("Press Enter to continue...")
+        return
+    
+    print(f"Reviews used: {result['reviews']}")
+    print(f"Prediction error (log loss): {result['loss_before']:.4f} → {result['loss_after']:.4f}")
+    if result['loss_after'] >= result['loss_before']:
+        print("✅ The current parameters already fit your history best.")
+        input("Press Enter to continue...")
+        return
+    
+    print(f"New parameters: {', '.join(f'{weight:g}' for weight in result['weights'])}")
+    if input("Save these parameters? [Y/n]: ").strip().lower() in ['', 'y', 'yes']:
+        db_manager.set_setting('fsrs_weights', ",".join(f"{weight:g}" for weight in result['weights']))
+        print("✅ FSRS parameters saved!")
+    input("Press Enter to continue...")
+
+
+def show_settings_window(db_manager):
+    """
+    Show the settings window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("⚙️  Settings")
+        print("=" * 30)
+        print()
+        
+        settings = db_manager.get_settings()
+        keys = list(DEFAULT_SETTINGS)
+        
+        for i, key in enumerate(keys, 1):
+            choices = f" ({'/'.join(SETTING_CHOICES[key])})" if key in SETTING_CHOICES else ""
+            print(f"[{i}] {SETTING_LABELS.get(key, key)}{choices}: {settings[key]}")
+        
+        print("\n[o] Optimize FSRS parameters from review history")
+        print("[c] Check data integrity")
+        print("[w] Webhooks")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nSetting to change: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            if choice == 'c':
+                show_integrity_check_window(db_manager)
+                continue
+            if choice == 'o':
+                optimize_fsrs_weights(db_manager)
+                continue
+            if choice == 'w':
+                show_webhooks_window(db_manager)
+                continue
+            
+            try:
+                setting_index = int(choice) - 1
+                if not 0 <= setting_index < len(keys):
+                    raise IndexError
+                key = keys[setting_index]
+            except (ValueError, IndexError):
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+                continue
+            
+            raw_value = input(f"New value (current: {settings[key]}, default: {DEFAULT_SETTINGS[key]}): ").strip()
+            if not raw_value:
+                continue
+            
+            try:
+                db_manager.set_setting(key, parse_setting_value(key, raw_value))
+                print("✅ Setting saved!")
+            except ValueError as e:
+                print(f"❌ Invalid value: {str(e)}")
+            input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
@@ -0,0 +1,303 @@
+"""
+Solutions window for DSA Recall GUI.
+
+This window lists the alternative solutions stored for a problem and lets
+users add, edit, delete them and pick the primary one. Solutions can also
+be reviewed on their own language track.
+"""
+
+from datetime import date
+
+from src.database.models import Solution
+from src.utils.editor import edit_approach, edit_code
+from src.utils.spaced_repetition import start_solution_track, stop_solution_track
+from src.scheduler.factory import get_scheduler
+from src.utils.notifications import notify_if_streak_milestone
+from src.utils.languages import get_supported_languages, resolve_language
+from src.utils.validation import validate_language
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def ask_language(current=""):
+    """
+    Ask for a solution's language, listing the supported ones.
+    
+    Args:
+        current: Current language, shown as a hint
+        
+    Returns:
+        str: Supported language as entered, lowercased ('' to detect it from the code)
+    """
+    print(f"Supported languages: {', '.join(get_supported_languages())}")
+    hint = f"current: {current}" if current else "leave empty to detect from the code"
+    while True:
+        language = input(f"Language ({hint}): ").strip().lower()
+        error = validate_language(language)
+        if not error:
+            return language
+        print(f"❌ {error}")
+
+
+def review_solution_track(db_manager, problem, solution):
+    """
+    Review a solution's language track: re-implement it, then grade it.
+    
+    Args:
+        db_manager: Database manager instance
+        problem: Problem the solution belongs to
+        solution: Tracked Solution instance to review
+    """
+    clear_screen()
+    print(f"🧩 Language Track: {problem.title} [{solution.language or 'no language'}]")
+    print("=" * 60)
+    print()
+    print(f"Re-implement this problem in {solution.language or 'the same language'} from memory,")
+    print("then compare with the stored code and grade yourself.")
+    print()
+    
+    while True:
+        choice = input("[c] Show stored code  [e] Easy ✅  [h] Hard ❌  [b] Back: ").strip().lower()
+        if choice == 'c':
+            print()
+            print(solution.code.strip() or "(no code stored)")
+            print()
+        elif choice in ('e', 'h'):
+            break
+        elif choice == 'b':
+            return
+        else:
+            print("Invalid choice! Please try again.")
+    
+    settings = db_manager.get_settings()
+    if choice == 'e':
+        get_scheduler(settings).mark_easy(solution, settings)
+        grade = 'easy'
+    else:
+        get_scheduler(settings).mark_hard(solution, settings)
+        grade = 'hard'
+    db_manager.save_solution_review(solution, grade)
+    notify_if_streak_milestone(db_manager)
+    print(f"✅ Track marked as {grade}! Next review: {solution.next_review}")
+    input("Press Enter to continue...")
+
+
+def show_solutions_window(db_manager, problem):
+    """
+    Show the solutions list for a problem.
+    
+    Args:
+        db_manager: Database manager instance
+        problem: Problem instance whose solutions are managed
+        
+    Returns:
+        bool: True if the primary solution changed the problem's code/approach
+    """
+    primary_changed = False
+    
+    while True:
+        clear_screen()
+        
+        print(f"🧩 Solutions: {problem.title}")
+        print("=" * 60)
+        print()
+        
+        solutions = db_manager.get_solutions(problem.id)
+        
+        if not solutions:
+            print("No solutions stored yet. The problem's own approach and code are used.")
+        else:
+            print(f"{'ID':<4} {'Primary':<8} {'Language':<12} {'Complexity':<30} {'Track review':<12}")
+            print("-" * 69)
+            
+            for solution in solutions:
+                primary = "⭐" if solution.is_primary else ""
+                complexity = solution.complexity[:28] + ".." if len(solution.complexity) > 30 else solution.complexity
+                print(f"{solution.id:<4} {primary:<8} {solution.language or '-':<12} {complexity:<30} "
+                      f"{str(solution.next_review or '-'):<12}")
+        
+        print("\nActions:")
+        print("[n] New solution")
+        if solutions:
+            print("[v<ID>] View/Edit solution (e.g., v1)")
+            print("[p<ID>] Make primary (e.g., p1)")
+            print("[d<ID>] Delete solution (e.g., d1)")
+            print("[t<ID>] Start/stop reviewing as a language track (e.g., t1)")
+            if any(solution.is_tracked for solution in solutions):
+                print("[r<ID>] Review language track (e.g., r1)")
+        print("[b] Back to problem card")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                solution = Solution(problem_id=problem.id)
+                language = ask_language()
+                solution.complexity = input("Complexity (e.g., O(n) time, O(1) space): ").strip()
+                try:
+                    edited_code = edit_code(solution.code, resolve_language(language, "") or "txt")
+                    if edited_code is not None:
+                        solution.code = edited_code
+                    edited_approach = edit_approach(solution.approach)
+                    if edited_approach is not None:
+                        solution.approach = edited_approach
+                except Exception as e:
+                    print(f"❌ Failed to open editor: {str(e)}")
+                
+                solution.language = resolve_language(language, solution.code)
+                if not language and solution.language:
+                    print(f"🔎 Detected language: {solution.language}")
+                
+                # The first solution of an empty problem becomes its primary one
+                if not solutions and not problem.code.strip() and not problem.approach.strip():
+                    solution.is_primary = True
+                    primary_changed = True
+                
+                solution_id = db_manager.add_solution(solution)
+                print(f"✅ Solution added! (ID: {solution_id})")
+                input("Press Enter to continue...")
+            elif choice.startswith(('v', 'p', 'd', 't', 'r')):
+                try:
+                    solution = db_manager.get_solution(int(choice[1:]))
+                except (ValueError, IndexError):
+                    print("Invalid solution ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                if not solution or solution.problem_id != problem.id:
+                    print("Solution not found!")
+                    input("Press Enter to continue...")
+                elif choice[0] == 'v':
+                    if show_solution_window(db_manager, solution) and solution.is_primary:
+                        primary_changed = True
+                elif choice[0] == 't':
+                    if solution.is_tracked:
+                        stop_solution_track(solution)
+                        print("✅ Stopped reviewing this language track.")
+                    else:
+                        start_solution_track(solution, db_manager.get_settings())
+                        print(f"✅ Language track started! First review: {solution.next_review}")
+                    db_manager.update_solution(solution)
+                    input("Press Enter to continue...")
+                elif choice[0] == 'r':
+                    if not solution.is_tracked:
+                        print("❌ This solution isn't reviewed as a language track. Start it with t<ID>.")
+                        input("Press Enter to continue...")
+                    elif solution.next_review > date.today():
+                        print(f"⚠️  Not due until {solution.next_review}.")
+                        if input("Review it anyway? [y/N]: ").strip().lower() in ['y', 'yes']:
+                            review_solution_track(db_manager, problem, solution)
+                    else:
+                        review_solution_track(db_manager, problem, solution)
+                elif choice[0] == 'p':
+                    db_manager.set_primary_solution(solution.id)
+                    primary_changed = True
+                    print("✅ Primary solution updated! The problem's approach and code now match it.")
+                    input("Press Enter to continue...")
+                else:
+                    confirm = input(f"Are you sure you want to delete solution {solution.id}? [y/N]: ").strip().lower()
+                    if confirm in ['y', 'yes']:
+                        db_manager.delete_solution(solution.id)
+                        print("✅ Solution deleted successfully.")
+                        input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
+    
+    return primary_changed
+
+
+def show_solution_window(db_manager, solution):
+    """
+    Show a single solution with edit options.
+    
+    Args:
+        db_manager: Database manager instance
+        solution: Solution instance to display
+        
+    Returns:
+        bool: True if the solution was saved, False otherwise
+    """
+    saved = False
+    
+    while True:
+        clear_screen()
+        
+        print(f"Solution {solution.id}{' ⭐ (primary)' if solution.is_primary else ''}")
+        print("=" * 60)
+        print()
+        
+        print(f"Language: {solution.language or '(not set)'}")
+        print(f"Complexity: {solution.complexity or '(not set)'}")
+        print(f"Approach: {'✅ Set' if solution.approach.strip() else '❌ Not set'}")
+        print(f"Code: {'✅ Set' if solution.code.strip() else '❌ Not set'}")
+        print()
+        
+        print("Actions:")
+        print("[a] View/Edit Approach (external editor)")
+        print("[c] View/Edit Code (external editor)")
+        print("[g] Edit language")
+        print("[x] Edit complexity")
+        print("[s] Save changes")
+        print("[b] Back to solutions")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'a':
+                try:
+                    edited_approach = edit_approach(solution.approach)
+                    if edited_approach is not None:
+                        solution.approach = edited_approach
+                        print("✅ Approach updated!")
+                    else:
+                        print("⚠️  Approach editing cancelled")
+                except Exception as e:
+                    print(f"❌ Failed to open editor: {str(e)}")
+                input("Press Enter to continue...")
+            elif choice == 'c':
+                try:
+                    edited_code = edit_code(solution.code, solution.language or "txt")
+                    if edited_code is not None:
+                        solution.code = edited_code
+                        print("✅ Code updated!")
+                    else:
+                        print("⚠️  Code editing cancelled")
+                except Exception as e:
+                    print(f"❌ Failed to open editor: {str(e)}")
+                input("Press Enter to continue...")
+            elif choice == 'g':
+                solution.language = resolve_language(ask_language(solution.language), solution.code)
+                print(f"✅ Language updated! ({solution.language or 'not detected'})")
+                input("Press Enter to continue...")
+            elif choice == 'x':
+                solution.complexity = input(f"Enter complexity (current: {solution.complexity or '(not set)'}): ").strip()
+                print("✅ Complexity updated!")
+                input("Press Enter to continue...")
+            elif choice == 's':
+                try:
+                    db_manager.update_solution(solution)
+                    saved = True
+                    print("✅ Solution saved successfully!")
+                except Exception as e:
+                    print(f"❌ Failed to save solution: {str(e)}")
+                input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
+    
+    return saved
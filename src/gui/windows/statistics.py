@@ -0,0 +1,102 @@
+"""
+Statistics window for DSA Recall GUI.
+
+This window summarizes the problem collection and review history.
+"""
+
+from src.config import DIFFICULTY_LEVELS
+
+# Number of tags (and companies) listed in the tag and company breakdowns
+TOP_TAGS = 10
+
+# Number of upcoming days shown in the review forecast, and the widest bar
+FORECAST_DAYS = 14
+FORECAST_BAR_WIDTH = 30
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def print_due_forecast(db_manager):
+    """
+    Print how many problems come due on each upcoming day as a bar chart.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    forecast = db_manager.get_due_forecast(FORECAST_DAYS)
+    busiest = max(day['due'] for day in forecast)
+    
+    print(f"\nUpcoming reviews (next {FORECAST_DAYS} days):")
+    print("-" * 20)
+    for day in forecast:
+        bar = "█" * (round(day['due'] / busiest * FORECAST_BAR_WIDTH) if busiest else 0)
+        if day['due'] and not bar:
+            bar = "▏"
+        print(f"{day['date'].strftime('%Y-%m-%d (%a)')} {day['due']:>4} {bar}".rstrip())
+
+
+def show_statistics_window(db_manager):
+    """
+    Show the statistics window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    clear_screen()
+    
+    print("📊 Statistics")
+    print("=" * 40)
+    print()
+    
+    stats = db_manager.get_statistics()
+    
+    print(f"Total problems: {stats['total_problems']}")
+    print(f"Reviews in the last 7 days: {stats['reviews_last_7_days']}")
+    print(f"Reviews in the last 30 days: {stats['reviews_last_30_days']}")
+    
+    retention = stats['retention_rate']
+    print(f"Retention (marked Easy): {f'{retention:.0%}' if retention is not None else 'n/a'}")
+    
+    interval = stats['average_interval_days']
+    print(f"Average interval: {f'{interval:.1f} days' if interval is not None else 'n/a'}")
+    
+    print(f"Current streak: {stats['current_streak']} days")
+    print(f"Longest streak: {stats['longest_streak']} days")
+    
+    print("\nBy difficulty:")
+    print("-" * 20)
+    for level in DIFFICULTY_LEVELS + ['']:
+        count = stats['by_difficulty'].get(level, 0)
+        if count or level:
+            print(f"{level or '(not set)':<12} {count}")
+    
+    print("\nBy tag:")
+    print("-" * 20)
+    if not stats['by_tag']:
+        print("No tagged problems yet.")
+    for tag, count in list(stats['by_tag'].items())[:TOP_TAGS]:
+        print(f"{tag:<20} {count}")
+    if len(stats['by_tag']) > TOP_TAGS:
+        print(f"... and {len(stats['by_tag']) - TOP_TAGS} more")
+    
+    print("\nBy source:")
+    print("-" * 20)
+    for source, count in stats['by_source'].items():
+        print(f"{source or '(not set)':<20} {count}")
+    
+    print("\nBy company:")
+    print("-" * 20)
+    if not stats['by_company']:
+        print("No problems tagged with companies yet.")
+    for company, count in list(stats['by_company'].items())[:TOP_TAGS]:
+        print(f"{company:<20} {count}")
+    if len(stats['by_company']) > TOP_TAGS:
+        print(f"... and {len(stats['by_company']) - TOP_TAGS} more")
+    
+    print_due_forecast(db_manager)
+    
+    input("\nPress Enter to continue...")
@@ -6,6 +6,13 @@ This window shows daily streak statistics and review history.
 
 from datetime import date, timedelta
 
+from src.config import STREAK_FREEZE_EARN_DAYS, STREAK_FREEZE_MAX, STREAK_MILESTONES
+from src.utils.heatmap import build_week_grid, ordered_weekdays, align_to_week_start, aggregate_activity, GRANULARITIES
+from src.utils.streaks import StreakService
+
+# Number of weeks shown in the activity calendar by default
+CALENDAR_WEEKS = 12
+
 
 def clear_screen():
     """Clear the screen for a cleaner interface."""
@@ -13,6 +20,98 @@ def clear_screen():
     os.system('cls' if os.name == 'nt' else 'clear')
 
 
+def activity_symbol(activity_count):
+    """
+    Pick the heatmap symbol for a day's review count.
+    
+    Args:
+        activity_count: Number of problems reviewed that day
+        
+    Returns:
+        str: Colored circle matching the legend
+    """
+    if activity_count == 0:
+        return "⚫"
+    elif activity_count <= 2:
+        return "🟡"
+    elif activity_count <= 4:
+        return "🟠"
+    return "🟢"
+
+
+def print_activity_calendar(db_manager, start_date, end_date):
+    """
+    Print a calendar heatmap for a date range, one row per weekday.
+    
+    Args:
+        db_manager: Database manager instance
+        start_date: First day to show
+        end_date: Last day to show
+    """
+    week_start = db_manager.get_settings()['week_start']
+    activity = db_manager.get_activity_range(start_date, end_date)
+    weeks = build_week_grid(activity, week_start)
+    
+    print(f"Activity Calendar ({start_date} to {end_date}):")
+    print("-" * 40)
+    
+    for weekday_index, weekday_name in enumerate(ordered_weekdays(week_start)):
+        cells = []
+        for week in weeks:
+            day = week[weekday_index]
+            cells.append(activity_symbol(day['problems_reviewed']) if day else "  ")
+        print(f"{weekday_name[:3]} {''.join(cells)}")
+    
+    total_reviewed = sum(day['problems_reviewed'] for day in activity)
+    active_days = sum(1 for day in activity if day['problems_reviewed'] > 0)
+    print(f"\n{total_reviewed} reviews on {active_days} of {len(activity)} days")
+
+
+def print_activity_totals(db_manager, start_date, end_date, granularity):
+    """
+    Print review totals per week or month for a date range.
+    
+    Args:
+        db_manager: Database manager instance
+        start_date: First day to include
+        end_date: Last day to include
+        granularity: 'week' or 'month'
+    """
+    week_start = db_manager.get_settings()['week_start']
+    buckets = aggregate_activity(db_manager.get_activity_range(start_date, end_date), granularity, week_start)
+    
+    print(f"Reviews per {granularity} ({start_date} to {end_date}):")
+    print("-" * 40)
+    
+    for bucket in buckets:
+        label = bucket['start'].strftime('%Y-%m') if granularity == 'month' else f"Week of {bucket['start']}"
+        print(f"{label:<20} {bucket['problems_reviewed']:>4} ({bucket['easy_reviewed']} easy / {bucket['hard_reviewed']} hard)")
+    
+    total_reviewed = sum(bucket['problems_reviewed'] for bucket in buckets)
+    print(f"\n{total_reviewed} reviews in {len(buckets)} {granularity}{'s' if len(buckets) != 1 else ''}")
+
+
+def ask_date(prompt, default):
+    """
+    Ask for a date in YYYY-MM-DD format.
+    
+    Args:
+        prompt: Prompt to show
+        default: Date used when the input is empty
+        
+    Returns:
+        date: Entered date, or None if the input was invalid
+    """
+    raw_value = input(f"{prompt} [{default}]: ").strip()
+    if not raw_value:
+        return default
+    try:
+        return date.fromisoformat(raw_value)
+    except ValueError:
+        print("❌ Dates must be in YYYY-MM-DD format")
+        return None
+
+
 def show_streak_tracker_window(db_manager):
     """
     Show the streak tracker window.
@@ -27,7 +126,8 @@ def show_streak_tracker_window(db_manager):
     print()
     
     # Get streak data
-    current_streak = db_manager.get_current_streak()
+    streak = StreakService(db_manager).summary()
+    current_streak = streak['current_streak']
     streak_data = db_manager.get_streak_data(days=14)
     
     # Calculate total problems reviewed
@@ -35,14 +135,21 @@ def show_streak_tracker_window(db_manager):
     
     # Display current streak
     print(f"Current Streak: 🔥 {current_streak} day{'s' if current_streak != 1 else ''}")
+    print(f"Longest Streak: {streak['longest_streak']} day{'s' if streak['longest_streak'] != 1 else ''}")
+    if streak['next_milestone']:
+        print(f"Next milestone: {streak['next_milestone']} days ({streak['next_milestone'] - current_streak} to go)")
+    print(f"Streak freezes: {'🧊' * streak['freeze_tokens'] or 'none'} "
+          f"(one per {STREAK_FREEZE_EARN_DAYS} streak days, up to {STREAK_FREEZE_MAX}, "
+          f"spent automatically on a missed day)")
+    badges = " ".join(f"🏅{days}" if days in streak['badges'] else f"·{days}" for days in STREAK_MILESTONES)
+    print(f"Badges: {badges}")
     print(f"Total problems reviewed in last 14 days: {total_reviewed}")
     print()
     
     # Create data lookup
     data_lookup = {}
     for day_data in streak_data:
-        day_date = day_data["date"]
-        data_lookup[day_date] = day_data.get("problems_reviewed", 0)
+        data_lookup[day_data["date"]] = day_data
     
     # Show recent activity
     print("Recent Activity (Last 14 Days):")
@@ -51,30 +158,64 @@ def show_streak_tracker_window(db_manager):
     today = date.today()
     for i in range(13, -1, -1):  # 14 days including today, reverse order
         check_date = today - timedelta(days=i)
-        activity_count = data_lookup.get(check_date, 0)
+        day_data = data_lookup.get(check_date.isoformat(), {})
+        activity_count = day_data.get("problems_reviewed", 0)
+        easy_count = day_data.get("easy_reviewed", 0)
+        hard_count = day_data.get("hard_reviewed", 0)
         
         # Format date and activity
         date_str = check_date.strftime("%Y-%m-%d (%a)")
         
         # Choose indicator based on activity
-        if activity_count == 0:
-            activity_indicator = "⚫"  # No activity
+        activity_indicator = activity_symbol(activity_count)
+        if activity_count == 0 and check_date in streak['frozen_days']:
+            activity_indicator = "🧊"
+            activity_text = "Covered by a streak freeze"
+        elif activity_count == 0:
             activity_text = "No problems reviewed"
-        elif activity_count <= 2:
-            activity_indicator = "🟡"  # Light activity
-            activity_text = f"{activity_count} problem{'s' if activity_count != 1 else ''} reviewed"
-        elif activity_count <= 4:
-            activity_indicator = "🟠"  # Medium activity
-            activity_text = f"{activity_count} problems reviewed"
         else:
-            activity_indicator = "🟢"  # High activity
-            activity_text = f"{activity_count} problems reviewed"
+            activity_text = f"{activity_count} problem{'s' if activity_count != 1 else ''} reviewed"
+        
+        # Show pass/fail breakdown so days can be judged by quality, not just quantity
+        if easy_count or hard_count:
+            quality_indicator = "✅" if easy_count >= hard_count else "❌"
+            activity_text += f" {quality_indicator} {easy_count} easy / {hard_count} hard"
         
         print(f"{activity_indicator} {date_str}: {activity_text}")
     
     print()
     print("Legend:")
-    print("🟢 5+ problems  🟠 3-4 problems  🟡 1-2 problems  ⚫ No activity")
+    print("🟢 5+ problems  🟠 3-4 problems  🟡 1-2 problems  ⚫ No activity  🧊 Streak freeze")
+    print("✅ Mostly easy  ❌ Mostly hard")
     print()
     
-    input("Press Enter to continue...")
\ No newline at end of file
+    # Show the last few weeks as a calendar, aligned to the configured week start
+    week_start = db_manager.get_settings()['week_start']
+    calendar_start = align_to_week_start(today - timedelta(weeks=CALENDAR_WEEKS - 1), week_start)
+    print_activity_calendar(db_manager, calendar_start, today)
+    print()
+    
+    while True:
+        choice = input("[c] Calendar for a custom date range, or press Enter to continue: ").strip().lower()
+        if choice != 'c':
+            break
+        
+        start_date = ask_date("From", calendar_start)
+        end_date = ask_date("To", today) if start_date else None
+        if not start_date or not end_date:
+            continue
+        if start_date > end_date:
+            print("❌ The start date must be before the end date")
+            continue
+        
+        granularity = input(f"Group by ({'/'.join(GRANULARITIES)}) [day]: ").strip().lower() or "day"
+        if granularity not in GRANULARITIES:
+            print(f"❌ Choose one of: {', '.join(GRANULARITIES)}")
+            continue
+        
+        print()
+        if granularity == "day":
+            print_activity_calendar(db_manager, start_date, end_date)
+        else:
+            print_activity_totals(db_manager, start_date, end_date, granularity)
+        print()
\ No newline at end of file
@@ -0,0 +1,77 @@
+"""
+Trash window for DSA Recall GUI.
+
+This window lists deleted problems and lets users restore them before
+they are purged for good.
+"""
+
+from src.config import TRASH_RETENTION_DAYS
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def show_trash_window(db_manager):
+    """
+    Show the trash window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("🗑️  Trash")
+        print("=" * 30)
+        print(f"Deleted problems are purged for good after {TRASH_RETENTION_DAYS} days.")
+        print()
+        
+        entries = db_manager.get_trash()
+        
+        if not entries:
+            print("The trash is empty.")
+        else:
+            print(f"{'ID':<4} {'Title':<40} {'Deleted':<12}")
+            print("-" * 58)
+            
+            for entry in entries:
+                title = entry['title'][:38] + ".." if len(entry['title']) > 40 else entry['title']
+                print(f"{entry['id']:<4} {title:<40} {str(entry['deleted_at'] or '-'):<12}")
+        
+        print("\nActions:")
+        if entries:
+            print("[r<ID>] Restore problem (e.g., r1)")
+            print("[e] Empty trash")
+        print("[b] Back to main dashboard")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'e' and entries:
+                prompt = f"Permanently delete {len(entries)} problem(s)? This can't be undone. [y/N]: "
+                confirm = input(prompt).strip().lower()
+                if confirm in ['y', 'yes']:
+                    purged = db_manager.purge_trash(older_than_days=0)
+                    print(f"✅ Purged {purged} problem(s).")
+                    input("Press Enter to continue...")
+            elif choice.startswith('r') and len(choice) > 1:
+                try:
+                    problem_id = db_manager.restore_from_trash(int(choice[1:]))
+                    if problem_id:
+                        print(f"✅ Problem restored! (ID: {problem_id})")
+                    else:
+                        print("Trash entry not found!")
+                except ValueError:
+                    print("Invalid trash ID!")
+                input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
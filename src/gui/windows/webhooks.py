@@ -0,0 +1,291 @@
+"""
+Webhooks window for DSA Recall GUI.
+
+This window manages outgoing webhooks (where app events are POSTed to,
+as JSON or as Discord and Telegram messages) and shows the log of
+deliveries made to them.
+"""
+
+from src.config import OFFLINE_MODE, WEBHOOK_EVENTS, CHANNELS, TELEGRAM_API_URL
+from src.database.models import Webhook
+from src.notifications.factory import get_channel
+from src.utils.webhooks import retry_delivery, send_test_event
+
+
+def clear_screen():
+    """Clear the screen for a cleaner interface."""
+    import os
+    os.system('cls' if os.name == 'nt' else 'clear')
+
+
+def ask_events(current):
+    """
+    Ask which events a webhook should receive.
+    
+    Args:
+        current: Current comma-separated events, kept if the input is empty
+        
+    Returns:
+        str: Comma-separated events from WEBHOOK_EVENTS
+    """
+    print("Events:")
+    for i, event in enumerate(WEBHOOK_EVENTS, 1):
+        print(f"  [{i}] {event}")
+    
+    while True:
+        answer = input(f"Events (numbers or names separated by commas, 'all', current: {current or '(none)'}): ").strip()
+        if not answer:
+            return current
+        if answer.lower() == 'all':
+            return ",".join(WEBHOOK_EVENTS)
+        
+        events = []
+        for part in (part.strip() for part in answer.split(',') if part.strip()):
+            if part.isdigit() and 1 <= int(part) <= len(WEBHOOK_EVENTS):
+                part = WEBHOOK_EVENTS[int(part) - 1]
+            if part not in WEBHOOK_EVENTS:
+                print(f"❌ Unknown event: {part}")
+                break
+            if part not in events:
+                events.append(part)
+        else:
+            if events:
+                return ",".join(events)
+            print("❌ Choose at least one event.")
+
+
+def ask_url(current=""):
+    """
+    Ask for a webhook URL.
+    
+    Args:
+        current: Current URL, kept if the input is empty
+        
+    Returns:
+        str: http(s) URL, or '' if none was given
+    """
+    while True:
+        prompt = f"URL (current: {current}): " if current else "URL (leave empty to cancel): "
+        answer = input(prompt).strip()
+        if not answer:
+            return current
+        if answer.lower().startswith(('http://', 'https://')):
+            return answer
+        print("❌ The URL must start with http:// or https://")
+
+
+def ask_destination(webhook):
+    """
+    Ask where a webhook sends to, depending on its channel.
+    
+    Empty answers keep the current values.
+    
+    Args:
+        webhook: Webhook to update in place
+        
+    Returns:
+        bool: True if the webhook has everything its channel needs
+    """
+    if webhook.channel == 'telegram':
+        webhook.url = webhook.url or TELEGRAM_API_URL
+        token = input("Bot token from @BotFather" + (" (leave empty to keep)" if webhook.secret else "") + ": ").strip()
+        webhook.secret = token or webhook.secret
+        chat_id = input(f"Chat ID (current: {webhook.target or '(not set)'}): ").strip()
+        webhook.target = chat_id or webhook.target
+        return bool(webhook.secret and webhook.target)
+    
+    if webhook.channel == 'discord':
+        print("Discord: Server Settings → Integrations → Webhooks → Copy Webhook URL")
+    webhook.url = ask_url(webhook.url)
+    if webhook.channel == 'webhook':
+        if webhook.id is None:
+            webhook.secret = input("Secret used to sign requests (leave empty to send unsigned): ").strip()
+        else:
+            secret = input("New secret (leave empty to keep, '-' to remove): ").strip()
+            if secret:
+                webhook.secret = '' if secret == '-' else secret
+    return bool(webhook.url)
+
+
+def show_webhooks_window(db_manager):
+    """
+    Show the webhooks window.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("📡 Webhooks")
+        print("=" * 30)
+        print()
+        if OFFLINE_MODE:
+            print("⚠️  Offline mode is on: events are queued but not sent.")
+            print()
+        
+        webhooks = db_manager.get_all_webhooks()
+        
+        if not webhooks:
+            print("No webhooks yet.")
+        else:
+            print(f"{'ID':<4} {'Active':<7} {'Channel':<9} {'Destination':<40} Events")
+            print("-" * 80)
+            
+            for webhook in webhooks:
+                destination = get_channel(webhook.channel).describe(webhook)
+                if webhook.channel == 'webhook' and webhook.secret:
+                    destination += " (signed)"
+                destination = destination[:38] + ".." if len(destination) > 40 else destination
+                active = "yes" if webhook.is_active else "paused"
+                print(f"{webhook.id:<4} {active:<7} {webhook.channel:<9} {destination:<40} "
+                      f"{', '.join(webhook.event_list)}")
+        
+        print("\nActions:")
+        print("[n] Add webhook")
+        if webhooks:
+            print("[e<ID>] Edit webhook (e.g., e1)")
+            print("[p<ID>] Pause/resume webhook (e.g., p1)")
+            print("[t<ID>] Send a test event (e.g., t1)")
+            print("[d<ID>] Delete webhook (e.g., d1)")
+            print("[l] Delivery log")
+        print("[b] Back to settings")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice == 'n':
+                channel = input(f"Channel ({'/'.join(CHANNELS)}, default: webhook): ").strip().lower() or "webhook"
+                if channel not in CHANNELS:
+                    print(f"❌ Choose one of: {', '.join(CHANNELS)}")
+                    input("Press Enter to continue...")
+                    continue
+                webhook = Webhook(channel=channel)
+                if not ask_destination(webhook):
+                    print("⚠️  Webhook not added.")
+                    input("Press Enter to continue...")
+                    continue
+                webhook.events = ask_events(",".join(WEBHOOK_EVENTS))
+                db_manager.add_webhook(webhook)
+                print("✅ Webhook added! Send a test event with t<ID>.")
+                input("Press Enter to continue...")
+            elif choice == 'l' and webhooks:
+                show_webhook_log_window(db_manager)
+            elif choice.startswith(('e', 'p', 't', 'd')) and len(choice) > 1:
+                try:
+                    webhook = db_manager.get_webhook(int(choice[1:]))
+                except ValueError:
+                    print("Invalid webhook ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                if not webhook:
+                    print("Webhook not found!")
+                elif choice[0] == 'e':
+                    ask_destination(webhook)
+                    webhook.events = ask_events(webhook.events)
+                    db_manager.update_webhook(webhook)
+                    print("✅ Webhook updated!")
+                elif choice[0] == 'p':
+                    webhook.is_active = not webhook.is_active
+                    db_manager.update_webhook(webhook)
+                    print("✅ Webhook resumed!" if webhook.is_active else "⏸️  Webhook paused.")
+                elif choice[0] == 't':
+                    if OFFLINE_MODE:
+                        print("❌ Offline mode is on, nothing can be sent.")
+                    else:
+                        delivery = send_test_event(db_manager, webhook)
+                        if delivery.status == 'delivered':
+                            print("✅ The webhook accepted the test event.")
+                        else:
+                            print(f"❌ Test failed: {delivery.last_error}")
+                else:
+                    destination = get_channel(webhook.channel).describe(webhook)
+                    confirm = input(f"Delete the webhook to {destination} and its delivery log? [y/N]: ").strip().lower()
+                    if confirm in ['y', 'yes']:
+                        db_manager.delete_webhook(webhook.id)
+                        print("✅ Webhook deleted.")
+                input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
+
+
+def show_webhook_log_window(db_manager):
+    """
+    Show the most recent webhook deliveries.
+    
+    Args:
+        db_manager: Database manager instance
+    """
+    while True:
+        clear_screen()
+        
+        print("📡 Webhook Delivery Log")
+        print("=" * 30)
+        print()
+        
+        deliveries = db_manager.get_webhook_deliveries()
+        
+        if not deliveries:
+            print("Nothing has been sent yet.")
+        else:
+            print(f"{'ID':<5} {'Time':<17} {'Hook':<5} {'Event':<18} {'Status':<10} {'Tries':<6} Result")
+            print("-" * 80)
+            
+            for delivery in deliveries:
+                created = delivery.created_at.strftime('%Y-%m-%d %H:%M') if delivery.created_at else "-"
+                if delivery.status == 'delivered':
+                    result = f"HTTP {delivery.response_code}"
+                elif delivery.status == 'pending' and delivery.next_attempt_at:
+                    result = f"next try {delivery.next_attempt_at.strftime('%H:%M')}"
+                    if delivery.last_error:
+                        result = f"{delivery.last_error[:20]}, {result}"
+                else:
+                    result = delivery.last_error[:40] or "-"
+                print(f"{delivery.id:<5} {created:<17} {delivery.webhook_id:<5} {delivery.event:<18} "
+                      f"{delivery.status:<10} {delivery.attempts:<6} {result}")
+        
+        print("\nActions:")
+        if deliveries:
+            print("[r<ID>] Resend delivery now (e.g., r1)")
+        print("[b] Back to webhooks")
+        
+        try:
+            choice = input("\nEnter your choice: ").strip().lower()
+            
+            if choice == 'b':
+                break
+            elif choice.startswith('r') and len(choice) > 1:
+                try:
+                    delivery_id = int(choice[1:])
+                except ValueError:
+                    print("Invalid delivery ID!")
+                    input("Press Enter to continue...")
+                    continue
+                
+                delivery = next((delivery for delivery in deliveries if delivery.id == delivery_id), None)
+                if not delivery:
+                    print("Delivery not found in the log!")
+                elif OFFLINE_MODE:
+                    print("❌ Offline mode is on, nothing can be sent.")
+                else:
+                    delivered = retry_delivery(db_manager, delivery)
+                    if delivered is None:
+                        print("That webhook no longer exists.")
+                    elif delivered:
+                        print("✅ Delivered!")
+                    else:
+                        print(f"❌ Delivery failed: {delivery.last_error}")
+                input("Press Enter to continue...")
+            else:
+                print("Invalid choice! Please try again.")
+                input("Press Enter to continue...")
+        
+        except KeyboardInterrupt:
+            break
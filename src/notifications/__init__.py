@@ -0,0 +1,3 @@
+"""
+Notification channels for DSA Recall.
+"""
@@ -0,0 +1,81 @@
+"""
+Notification channel interface for DSA Recall.
+
+This module defines what every channel provides, so webhook deliveries
+can be sent without knowing whether they go to a plain URL or a chat app.
+"""
+
+import json
+import urllib.request
+from abc import ABC, abstractmethod
+
+from src.database.models import Webhook, WebhookDelivery
+from .messages import format_message
+
+
+class Channel(ABC):
+    """
+    Turns a queued webhook delivery into an HTTP request.
+    
+    Every channel receives the same delivery payload; chat channels send
+    a readable message built from it instead of the raw JSON.
+    """
+    
+    name = ""
+    
+    @abstractmethod
+    def build_request(self, webhook: Webhook, delivery: WebhookDelivery) -> urllib.request.Request:
+        """
+        Build the POST request that sends a delivery.
+        
+        Args:
+            webhook: Webhook (or chat) the delivery goes to
+            delivery: Delivery to send
+            
+        Returns:
+            urllib.request.Request: Request ready to be opened
+        """
+    
+    def describe(self, webhook: Webhook) -> str:
+        """
+        Describe where a webhook sends to, for lists.
+        
+        Args:
+            webhook: Webhook using this channel
+            
+        Returns:
+            str: Short destination such as its URL
+        """
+        return webhook.url
+    
+    @staticmethod
+    def message(delivery: WebhookDelivery) -> str:
+        """
+        Get the chat message for a delivery.
+        
+        Args:
+            delivery: Delivery to describe
+            
+        Returns:
+            str: Readable message for the delivery's event
+        """
+        return format_message(json.loads(delivery.payload))
+    
+    @staticmethod
+    def json_request(url: str, body: dict) -> urllib.request.Request:
+        """
+        Build a JSON POST request.
+        
+        Args:
+            url: Address to send to
+            body: JSON-serializable request body
+            
+        Returns:
+            urllib.request.Request: Request ready to be opened
+        """
+        return urllib.request.Request(
+            url,
+            data=json.dumps(body, ensure_ascii=False).encode('utf-8'),
+            headers={'Content-Type': 'application/json', 'User-Agent': 'dsa-recall'},
+            method='POST'
+        )
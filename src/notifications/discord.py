@@ -0,0 +1,33 @@
+"""
+Discord channel for DSA Recall.
+
+This channel posts a chat message through a Discord channel webhook
+(Server Settings → Integrations → Webhooks → Copy Webhook URL).
+"""
+
+import urllib.request
+
+from src.database.models import Webhook, WebhookDelivery
+from .base import Channel
+
+# Longest message Discord accepts
+DISCORD_MAX_LENGTH = 2000
+
+
+class DiscordChannel(Channel):
+    """Sends each event as a message to a Discord webhook URL."""
+    
+    name = "discord"
+    
+    def build_request(self, webhook: Webhook, delivery: WebhookDelivery) -> urllib.request.Request:
+        """
+        Build a Discord webhook message.
+        
+        Args:
+            webhook: Webhook holding the Discord webhook URL
+            delivery: Delivery to send
+            
+        Returns:
+            urllib.request.Request: Request ready to be opened
+        """
+        return self.json_request(webhook.url, {'content': self.message(delivery)[:DISCORD_MAX_LENGTH]})
@@ -0,0 +1,35 @@
+"""
+Notification channel selection.
+
+This module creates the channel a webhook sends its events through.
+"""
+
+from src.config import CHANNELS
+from src.errors import ValidationError
+
+from .base import Channel
+from .discord import DiscordChannel
+from .telegram import TelegramChannel
+from .webhook import WebhookChannel
+
+
+def get_channel(name: str) -> Channel:
+    """
+    Get a notification channel by name.
+    
+    Args:
+        name: Channel name (one of CHANNELS)
+        
+    Returns:
+        Channel: Channel instance
+        
+    Raises:
+        ValidationError: If the channel is unknown
+    """
+    if name == "webhook":
+        return WebhookChannel()
+    if name == "discord":
+        return DiscordChannel()
+    if name == "telegram":
+        return TelegramChannel()
+    raise ValidationError(f"Unknown channel '{name}', choose one of: {', '.join(CHANNELS)}")
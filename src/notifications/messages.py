@@ -0,0 +1,50 @@
+"""
+Chat message formatting for notification channels.
+
+This module turns webhook event payloads into short, readable messages
+for chat apps such as Discord and Telegram.
+"""
+
+from typing import Dict, Any
+
+from src.config import (
+    EVENT_REVIEW_COMPLETED, EVENT_PROBLEMS_DUE, EVENT_STREAK_BROKEN, EVENT_STREAK_AT_RISK, DUE_SUMMARY_TITLES
+)
+
+
+def format_message(payload: Dict[str, Any]) -> str:
+    """
+    Build a chat message for a webhook event.
+    
+    Args:
+        payload: Event payload ({'event': str, 'created_at': str, 'data': dict})
+        
+    Returns:
+        str: Readable message
+    """
+    event = payload.get('event', '')
+    data = payload.get('data') or {}
+    
+    if event == EVENT_REVIEW_COMPLETED:
+        grade = "✅ Easy" if data.get('grade') == 'easy' else "❌ Hard"
+        return f"{grade}: '{data.get('title')}'. Next review: {data.get('next_review') or '(not scheduled)'}"
+    if event == EVENT_PROBLEMS_DUE:
+        titles = [problem['title'] for problem in data.get('problems', [])]
+        message = f"📅 {data.get('count', len(titles))} problem(s) due today: {', '.join(titles[:DUE_SUMMARY_TITLES])}"
+        if len(titles) > DUE_SUMMARY_TITLES:
+            message += f" and {len(titles) - DUE_SUMMARY_TITLES} more"
+        return message
+    if event == EVENT_STREAK_BROKEN:
+        return (f"💔 Your {data.get('streak_days')}-day review streak ended "
+                f"(last review: {data.get('last_review_date')}).")
+    if event == EVENT_STREAK_AT_RISK:
+        message = (f"⏳ You haven't reviewed today, {data.get('hours_left')} hour(s) left "
+                   f"to keep your {data.get('streak_days')}-day streak.")
+        if data.get('freeze_tokens'):
+            message += " A streak freeze will cover today if you miss it."
+        return message
+    if event == 'test':
+        return "🧠 DSA Recall test message: this channel is set up correctly."
+    if data.get('message'):
+        return data['message']
+    return f"DSA Recall event: {event}"
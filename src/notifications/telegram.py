@@ -0,0 +1,53 @@
+"""
+Telegram channel for DSA Recall.
+
+This channel sends a chat message from a Telegram bot (created with
+@BotFather) to a chat the bot has been started in.
+"""
+
+import urllib.request
+
+from src.database.models import Webhook, WebhookDelivery
+from .base import Channel
+
+# Longest message Telegram accepts
+TELEGRAM_MAX_LENGTH = 4096
+
+
+class TelegramChannel(Channel):
+    """
+    Sends each event as a Telegram bot message.
+    
+    The webhook's url is the Bot API server, its secret the bot token and
+    its target the chat ID.
+    """
+    
+    name = "telegram"
+    
+    def build_request(self, webhook: Webhook, delivery: WebhookDelivery) -> urllib.request.Request:
+        """
+        Build a Bot API sendMessage call.
+        
+        Args:
+            webhook: Webhook holding the API URL, bot token and chat ID
+            delivery: Delivery to send
+            
+        Returns:
+            urllib.request.Request: Request ready to be opened
+        """
+        return self.json_request(
+            f"{webhook.url.rstrip('/')}/bot{webhook.secret}/sendMessage",
+            {'chat_id': webhook.target, 'text': self.message(delivery)[:TELEGRAM_MAX_LENGTH]}
+        )
+    
+    def describe(self, webhook: Webhook) -> str:
+        """
+        Describe the chat a webhook sends to, without the bot token.
+        
+        Args:
+            webhook: Webhook using this channel
+            
+        Returns:
+            str: Chat description
+        """
+        return f"Telegram chat {webhook.target}"
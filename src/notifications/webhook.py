@@ -0,0 +1,56 @@
+"""
+Plain webhook channel for DSA Recall.
+
+This channel POSTs the event payload as JSON, signed with the webhook's
+secret so receivers can check where it came from.
+"""
+
+import hashlib
+import hmac
+import urllib.request
+
+from src.config import WEBHOOK_SIGNATURE_HEADER
+from src.database.models import Webhook, WebhookDelivery
+from .base import Channel
+
+
+def sign_payload(secret: str, body: bytes) -> str:
+    """
+    Sign a request body so receivers can check it came from this app.
+    
+    Args:
+        secret: Webhook secret
+        body: Raw request body
+        
+    Returns:
+        str: Signature such as 'sha256=<hex digest>'
+    """
+    return "sha256=" + hmac.new(secret.encode('utf-8'), body, hashlib.sha256).hexdigest()
+
+
+class WebhookChannel(Channel):
+    """Sends the event payload as signed JSON to any URL."""
+    
+    name = "webhook"
+    
+    def build_request(self, webhook: Webhook, delivery: WebhookDelivery) -> urllib.request.Request:
+        """
+        Build a JSON POST of the delivery's payload.
+        
+        Args:
+            webhook: Webhook the delivery goes to
+            delivery: Delivery to send
+            
+        Returns:
+            urllib.request.Request: Request ready to be opened
+        """
+        body = delivery.payload.encode('utf-8')
+        headers = {
+            'Content-Type': 'application/json',
+            'User-Agent': 'dsa-recall',
+            'X-DSARecall-Event': delivery.event,
+            'X-DSARecall-Delivery': str(delivery.id),
+        }
+        if webhook.secret:
+            headers[WEBHOOK_SIGNATURE_HEADER] = sign_payload(webhook.secret, body)
+        return urllib.request.Request(webhook.url, data=body, headers=headers, method='POST')
@@ -0,0 +1,3 @@
+"""
+Review scheduling algorithms for DSA Recall.
+"""
@@ -0,0 +1,59 @@
+"""
+Scheduler interface for DSA Recall.
+
+This module defines what every review scheduling algorithm provides, so
+review screens can grade problems without knowing which algorithm the
+user picked.
+"""
+
+from abc import ABC, abstractmethod
+from typing import Dict, Any
+
+from src.database.models import Problem
+from src.utils.spaced_repetition import mark_problem_auto_hard
+
+
+class Scheduler(ABC):
+    """
+    Decides when a problem is reviewed next after it is graded.
+    
+    Schedulers update the problem in place (streak level, next review,
+    last marked date and history) and keep any state of their own in the
+    problem's scheduler_state under their name. A solution's language
+    track is graded the same way.
+    """
+    
+    name = ""
+    
+    @abstractmethod
+    def mark_easy(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Grade a problem as easy and schedule its next review.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+    
+    @abstractmethod
+    def mark_hard(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Grade a problem as hard and schedule its next review.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+    
+    def mark_lapse(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Record that a problem went overdue ('auto-hard') and make it due today.
+        
+        Schedulers that keep state of their own override this to apply
+        the lapse to it.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        mark_problem_auto_hard(problem)
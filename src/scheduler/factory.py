@@ -0,0 +1,40 @@
+"""
+Scheduler selection.
+
+This module creates the scheduler chosen in the user's settings.
+"""
+
+from typing import Dict, Any
+
+from src.config import SCHEDULERS
+from src.errors import ValidationError
+from src.utils.spaced_repetition import resolve_settings
+
+from .base import Scheduler
+from .fsrs import FSRSScheduler, load_weights
+from .leitner import LeitnerScheduler
+from .streak import StreakScheduler
+
+
+def get_scheduler(settings: Dict[str, Any] = None) -> Scheduler:
+    """
+    Get the scheduler chosen in the settings.
+    
+    Args:
+        settings: User settings (defaults are used if omitted)
+        
+    Returns:
+        Scheduler: Scheduler instance
+        
+    Raises:
+        ValidationError: If the configured scheduler is unknown
+    """
+    settings = resolve_settings(settings)
+    name = settings['scheduler']
+    if name == "streak":
+        return StreakScheduler()
+    if name == "leitner":
+        return LeitnerScheduler()
+    if name == "fsrs":
+        return FSRSScheduler(load_weights(settings))
+    raise ValidationError(f"Unknown scheduler '{name}', choose one of: {', '.join(SCHEDULERS)}")
@@ -0,0 +1,306 @@
+"""
+FSRS (Free Spaced Repetition Scheduler).
+
+FSRS keeps two numbers for each problem: stability (days until the
+chance of remembering it drops to 90%) and difficulty (1-10). Each review
+updates both, and the next review is scheduled for when the chance of
+remembering the problem reaches the desired retention.
+
+Easy counts as a successful recall ('Good' in FSRS terms) and Hard as a
+lapse ('Again'). The stored state records how many reviews it covers.
+Problems without FSRS state, or reviewed since it was stored (e.g. under
+another scheduler), get it by replaying their review history, so
+switching schedulers keeps what was learned.
+"""
+
+import math
+from datetime import date, timedelta
+from typing import Dict, Any, List, Optional, Tuple
+
+from src.config import INITIAL_STREAK_LEVEL, FSRS_DEFAULT_WEIGHTS
+from src.database.models import Problem
+from src.utils.spaced_repetition import resolve_settings, skip_holidays, cap_interval, mark_problem_auto_hard
+
+from .base import Scheduler
+
+# Shape of the forgetting curve
+DECAY = -0.5
+FACTOR = 0.9 ** (1 / DECAY) - 1
+
+# FSRS ratings used for the two grades
+GRADE_AGAIN = 1
+GRADE_GOOD = 3
+
+# History statuses that count as reviews, and their FSRS rating
+REVIEW_GRADES = {
+    'easy': GRADE_GOOD,
+    'hard': GRADE_AGAIN,
+    'auto-hard': GRADE_AGAIN,
+}
+
+# Memory state: (stability in days, difficulty from 1 to 10)
+MemoryState = Tuple[float, float]
+
+
+def load_weights(settings: Dict[str, Any] = None) -> List[float]:
+    """
+    Get the FSRS parameters from the settings.
+    
+    Args:
+        settings: User settings (defaults are used if omitted)
+        
+    Returns:
+        List[float]: Stored parameters, or FSRS_DEFAULT_WEIGHTS if none are stored
+    """
+    raw_value = resolve_settings(settings)['fsrs_weights']
+    try:
+        weights = [float(value) for value in raw_value.split(',')] if raw_value else []
+    except ValueError:
+        weights = []
+    return weights if len(weights) == len(FSRS_DEFAULT_WEIGHTS) else list(FSRS_DEFAULT_WEIGHTS)
+
+
+def retrievability(elapsed_days: float, stability: float) -> float:
+    """
+    Calculate the chance of remembering a problem.
+    
+    Args:
+        elapsed_days: Days since the last review
+        stability: Memory stability in days
+        
+    Returns:
+        float: Probability of recall, from 0 to 1
+    """
+    return (1 + FACTOR * max(elapsed_days, 0) / stability) ** DECAY
+
+
+def next_interval(stability: float, desired_retention: float) -> int:
+    """
+    Calculate the days until recall drops to the desired retention.
+    
+    Args:
+        stability: Memory stability in days
+        desired_retention: Target probability of recall
+        
+    Returns:
+        int: Interval in days (at least 1)
+    """
+    interval = stability / FACTOR * (desired_retention ** (1 / DECAY) - 1)
+    return max(1, round(interval))
+
+
+def _clamp_difficulty(difficulty: float) -> float:
+    """
+    Keep a difficulty within the 1-10 range.
+    
+    Args:
+        difficulty: Difficulty value
+        
+    Returns:
+        float: Clamped difficulty
+    """
+    return min(max(difficulty, 1.0), 10.0)
+
+
+def initial_state(weights: List[float], grade: int) -> MemoryState:
+    """
+    Calculate the memory state after a problem's first review.
+    
+    Args:
+        weights: FSRS parameters
+        grade: FSRS rating of the review
+        
+    Returns:
+        MemoryState: (stability, difficulty)
+    """
+    stability = max(weights[grade - 1], 0.1)
+    difficulty = _clamp_difficulty(weights[4] - (grade - 3) * weights[5])
+    return stability, difficulty
+
+
+def next_state(weights: List[float], state: MemoryState, elapsed_days: float, grade: int) -> MemoryState:
+    """
+    Calculate the memory state after a later review.
+    
+    Args:
+        weights: FSRS parameters
+        state: (stability, difficulty) before the review
+        elapsed_days: Days since the previous review
+        grade: FSRS rating of the review
+        
+    Returns:
+        MemoryState: (stability, difficulty) after the review
+    """
+    stability, difficulty = state
+    recall = retrievability(elapsed_days, stability)
+    
+    # Difficulty moves with the grade and reverts slightly towards its initial value
+    new_difficulty = difficulty - weights[6] * (grade - 3)
+    new_difficulty = weights[7] * initial_state(weights, GRADE_GOOD)[1] + (1 - weights[7]) * new_difficulty
+    
+    if grade == GRADE_AGAIN:
+        new_stability = (weights[11] * difficulty ** -weights[12] * ((stability + 1) ** weights[13] - 1)
+                         * math.exp(weights[14] * (1 - recall)))
+        new_stability = min(new_stability, stability)
+    else:
+        new_stability = stability * (1 + math.exp(weights[8]) * (11 - difficulty) * stability ** -weights[9]
+                                     * (math.exp(weights[10] * (1 - recall)) - 1))
+    
+    return max(new_stability, 0.1), _clamp_difficulty(new_difficulty)
+
+
+def review_log(history: List[Dict[str, Any]]) -> List[Tuple[date, int]]:
+    """
+    Extract the graded reviews from a review history.
+    
+    Args:
+        history: Review history entries (oldest first)
+        
+    Returns:
+        List of (review date, FSRS rating) tuples
+    """
+    reviews = []
+    for entry in history:
+        grade = REVIEW_GRADES.get(entry.get('status'))
+        if grade is None:
+            continue
+        try:
+            reviews.append((date.fromisoformat(entry['date']), grade))
+        except (KeyError, TypeError, ValueError):
+            continue
+    return reviews
+
+
+def replay_reviews(weights: List[float], reviews: List[Tuple[date, int]]) -> Optional[MemoryState]:
+    """
+    Calculate a memory state by replaying graded reviews.
+    
+    Args:
+        weights: FSRS parameters
+        reviews: (review date, FSRS rating) tuples, oldest first
+        
+    Returns:
+        MemoryState: (stability, difficulty) after the last review, or None if there are no reviews
+    """
+    state = None
+    previous_date = None
+    for review_date, grade in reviews:
+        if state is None:
+            state = initial_state(weights, grade)
+        else:
+            state = next_state(weights, state, (review_date - previous_date).days, grade)
+        previous_date = review_date
+    return state
+
+
+class FSRSScheduler(Scheduler):
+    """Schedules reviews for when recall reaches the desired retention."""
+    
+    name = "fsrs"
+    
+    def __init__(self, weights: List[float] = None):
+        """
+        Initialize the scheduler.
+        
+        Args:
+            weights: FSRS parameters (FSRS_DEFAULT_WEIGHTS if omitted)
+        """
+        self.weights = list(weights or FSRS_DEFAULT_WEIGHTS)
+    
+    def get_memory_state(self, problem: Problem) -> Optional[MemoryState]:
+        """
+        Get a problem's memory state before its next review.
+        
+        The stored state is only used while it covers every graded review
+        in the history; otherwise the history is replayed.
+        
+        Args:
+            problem: Problem instance
+            
+        Returns:
+            MemoryState: (stability, difficulty), or None if the problem was never reviewed
+        """
+        reviews = review_log(problem.history_list)
+        state = problem.get_scheduler_state(self.name)
+        if 'stability' in state and 'difficulty' in state and state.get('reviews') == len(reviews):
+            return float(state['stability']), float(state['difficulty'])
+        return replay_reviews(self.weights, reviews)
+    
+    def mark_easy(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Grade a problem as easy and schedule its next review.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        problem.streak_level += 1
+        self._schedule(problem, GRADE_GOOD, "easy", settings)
+    
+    def mark_hard(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Grade a problem as hard and schedule its next review.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        problem.streak_level = INITIAL_STREAK_LEVEL
+        self._schedule(problem, GRADE_AGAIN, "hard", settings)
+    
+    def mark_lapse(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Apply an overdue problem's lapse to its memory state and make it due today.
+        
+        The stored state ends up the same as replaying the history with
+        the 'auto-hard' entry would give.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        problem.set_scheduler_state(self.name, self._state_after(problem, GRADE_AGAIN, date.today()))
+        mark_problem_auto_hard(problem)
+    
+    def _state_after(self, problem: Problem, grade: int, today: date) -> Dict[str, float]:
+        """
+        Calculate a problem's memory state after a review today.
+        
+        Args:
+            problem: Problem instance (not yet updated for the review)
+            grade: FSRS rating of the review
+            today: Day of the review
+            
+        Returns:
+            dict: Rounded stability and difficulty, and the number of graded
+            reviews they cover (including this one), as stored in scheduler_state
+        """
+        reviews = review_log(problem.history_list)
+        state = self.get_memory_state(problem)
+        if state is None:
+            state = initial_state(self.weights, grade)
+        else:
+            state = next_state(self.weights, state, (today - reviews[-1][0]).days, grade)
+        stability, difficulty = state
+        return {'stability': round(stability, 4), 'difficulty': round(difficulty, 4), 'reviews': len(reviews) + 1}
+    
+    def _schedule(self, problem: Problem, grade: int, result: str, settings: Dict[str, Any] = None) -> None:
+        """
+        Update a problem's memory state and schedule its next review.
+        
+        Args:
+            problem: Problem instance to update
+            grade: FSRS rating of the review
+            result: Review result for the history ('easy' or 'hard')
+            settings: User settings (defaults are used if omitted)
+        """
+        settings = resolve_settings(settings)
+        today = date.today()
+        
+        state = self._state_after(problem, grade, today)
+        
+        interval_days = cap_interval(next_interval(state['stability'], settings['desired_retention']), settings)
+        problem.next_review = skip_holidays(today + timedelta(days=interval_days), settings)
+        problem.last_marked = today
+        problem.set_scheduler_state(self.name, state)
+        problem.add_history_entry(result)
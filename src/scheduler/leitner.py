@@ -0,0 +1,90 @@
+"""
+Leitner box scheduler.
+
+Problems move up one box on each Easy and back to the first box on a
+Hard. Each box has a fixed review interval (LEITNER_BOX_INTERVALS).
+"""
+
+from datetime import date, timedelta
+from typing import Dict, Any
+
+from src.config import INITIAL_STREAK_LEVEL, LEITNER_BOX_INTERVALS
+from src.database.models import Problem
+from src.utils.spaced_repetition import resolve_settings, skip_holidays, cap_interval, mark_problem_auto_hard
+
+from .base import Scheduler
+
+
+class LeitnerScheduler(Scheduler):
+    """Schedules reviews with a fixed interval per Leitner box."""
+    
+    name = "leitner"
+    
+    def get_box(self, problem: Problem) -> int:
+        """
+        Get the box a problem is in.
+        
+        Problems without Leitner state yet (e.g. reviewed with another
+        scheduler before) start in the box matching their streak level.
+        
+        Args:
+            problem: Problem instance
+            
+        Returns:
+            int: Box number, from 1 to len(LEITNER_BOX_INTERVALS)
+        """
+        box = problem.get_scheduler_state(self.name).get('box')
+        if not isinstance(box, int):
+            box = problem.streak_level - INITIAL_STREAK_LEVEL + 1
+        return max(1, min(box, len(LEITNER_BOX_INTERVALS)))
+    
+    def mark_easy(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Grade a problem as easy and move it up one box.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        box = min(self.get_box(problem) + 1, len(LEITNER_BOX_INTERVALS))
+        problem.streak_level += 1
+        self._schedule(problem, box, "easy", settings)
+    
+    def mark_hard(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Grade a problem as hard and move it back to the first box.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        problem.streak_level = INITIAL_STREAK_LEVEL
+        self._schedule(problem, 1, "hard", settings)
+    
+    def mark_lapse(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Record that a problem went overdue and move it back to the first box.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        mark_problem_auto_hard(problem)
+        problem.set_scheduler_state(self.name, {'box': 1})
+    
+    def _schedule(self, problem: Problem, box: int, result: str, settings: Dict[str, Any] = None) -> None:
+        """
+        Put a problem in a box and schedule its review after the box's interval.
+        
+        Args:
+            problem: Problem instance to update
+            box: Box number to move the problem to
+            result: Review result for the history ('easy' or 'hard')
+            settings: User settings (defaults are used if omitted)
+        """
+        settings = resolve_settings(settings)
+        interval_days = cap_interval(LEITNER_BOX_INTERVALS[box - 1], settings)
+        problem.next_review = skip_holidays(date.today() + timedelta(days=interval_days), settings)
+        problem.last_marked = date.today()
+        problem.set_scheduler_state(self.name, {'box': box})
+        problem.add_history_entry(result)
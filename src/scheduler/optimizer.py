@@ -0,0 +1,134 @@
+"""
+FSRS parameter optimization.
+
+This module fits the FSRS parameters to the user's own review history:
+every problem's graded reviews are replayed, and the parameters are
+adjusted until the predicted chance of recall best matches whether each
+review was actually Easy or Hard.
+"""
+
+import math
+from typing import Dict, Any, List, Tuple
+
+from src.config import FSRS_DEFAULT_WEIGHTS, FSRS_MIN_REVIEWS
+from src.database.models import Problem
+from src.errors import ValidationError
+
+from .fsrs import initial_state, next_state, retrievability, review_log, GRADE_AGAIN
+
+# Allowed range of each FSRS parameter
+WEIGHT_BOUNDS = [
+    (0.1, 100.0), (0.1, 100.0), (0.1, 100.0), (0.1, 100.0),
+    (1.0, 10.0), (0.1, 5.0), (0.1, 5.0), (0.0, 0.75),
+    (0.0, 4.0), (0.0, 0.8), (0.01, 3.0),
+    (0.1, 5.0), (0.01, 0.2), (0.01, 0.9), (0.01, 2.0),
+    (0.0, 1.0), (1.0, 6.0),
+]
+
+# Relative step sizes tried for each parameter, largest first
+STEP_SIZES = [0.5, 0.2, 0.05, 0.01]
+
+# Rounds over all parameters per step size
+ROUNDS_PER_STEP = 3
+
+# Each review sequence: (days since the previous review, FSRS rating)
+ReviewSequence = List[Tuple[int, int]]
+
+
+def collect_review_sequences(problems: List[Problem]) -> List[ReviewSequence]:
+    """
+    Get every problem's graded reviews as sequences to fit against.
+    
+    Args:
+        problems: Problems with review history
+        
+    Returns:
+        List of review sequences, one per problem with at least two reviews
+    """
+    sequences = []
+    for problem in problems:
+        reviews = review_log(problem.history_list)
+        if len(reviews) < 2:
+            continue
+        sequence = [(0, reviews[0][1])]
+        for (previous_date, _), (review_date, grade) in zip(reviews, reviews[1:]):
+            sequence.append(((review_date - previous_date).days, grade))
+        sequences.append(sequence)
+    return sequences
+
+
+def log_loss(weights: List[float], sequences: List[ReviewSequence]) -> float:
+    """
+    Measure how well parameters predict the recorded reviews.
+    
+    Args:
+        weights: FSRS parameters
+        sequences: Review sequences from collect_review_sequences
+        
+    Returns:
+        float: Average log loss over all predicted reviews (lower is better)
+    """
+    total = 0.0
+    count = 0
+    for sequence in sequences:
+        state = initial_state(weights, sequence[0][1])
+        for elapsed_days, grade in sequence[1:]:
+            recall = min(max(retrievability(elapsed_days, state[0]), 1e-6), 1 - 1e-6)
+            total -= math.log(1 - recall) if grade == GRADE_AGAIN else math.log(recall)
+            count += 1
+            state = next_state(weights, state, elapsed_days, grade)
+    return total / count if count else 0.0
+
+
+def optimize_weights(problems: List[Problem], weights: List[float] = None) -> Dict[str, Any]:
+    """
+    Fit FSRS parameters to the review history of a set of problems.
+    
+    Each parameter in turn is nudged up and down within WEIGHT_BOUNDS,
+    keeping changes that lower the log loss, with smaller and smaller steps.
+    
+    Args:
+        problems: Problems whose review history is used
+        weights: Parameters to start from (FSRS_DEFAULT_WEIGHTS if omitted)
+        
+    Returns:
+        dict: 'weights' (fitted parameters), 'reviews' (number of reviews
+              predicted), 'loss_before' and 'loss_after'
+        
+    Raises:
+        ValidationError: If there are fewer than FSRS_MIN_REVIEWS reviews to fit
+    """
+    sequences = collect_review_sequences(problems)
+    review_count = sum(len(sequence) - 1 for sequence in sequences)
+    if review_count < FSRS_MIN_REVIEWS:
+        raise ValidationError(
+            f"Optimizing needs at least {FSRS_MIN_REVIEWS} repeat reviews, only {review_count} recorded so far"
+        )
+    
+    best = list(weights or FSRS_DEFAULT_WEIGHTS)
+    loss_before = best_loss = log_loss(best, sequences)
+    
+    for step in STEP_SIZES:
+        for _ in range(ROUNDS_PER_STEP):
+            improved = False
+            for index, (low, high) in enumerate(WEIGHT_BOUNDS):
+                for direction in (1, -1):
+                    candidate = list(best)
+                    change = max(abs(best[index]), 0.01) * step * direction
+                    candidate[index] = min(max(best[index] + change, low), high)
+                    if candidate[index] == best[index]:
+                        continue
+                    loss = log_loss(candidate, sequences)
+                    if loss < best_loss:
+                        best, best_loss = candidate, loss
+                        improved = True
+                        break
+            if not improved:
+                break
+    
+    return {
+        'weights': [round(weight, 4) for weight in best],
+        'reviews': review_count,
+        'loss_before': loss_before,
+        'loss_after': best_loss,
+    }
@@ -0,0 +1,39 @@
+"""
+Streak-doubling scheduler.
+
+This is the original DSA Recall algorithm: every Easy doubles the
+interval and a Hard resets (or steps back) the streak.
+"""
+
+from typing import Dict, Any
+
+from src.database.models import Problem
+from src.utils.spaced_repetition import mark_problem_easy, mark_problem_hard
+
+from .base import Scheduler
+
+
+class StreakScheduler(Scheduler):
+    """Schedules reviews with intervals that double on each Easy."""
+    
+    name = "streak"
+    
+    def mark_easy(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Grade a problem as easy and schedule its next review.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        mark_problem_easy(problem, settings)
+    
+    def mark_hard(self, problem: Problem, settings: Dict[str, Any] = None) -> None:
+        """
+        Grade a problem as hard and schedule its next review.
+        
+        Args:
+            problem: Problem instance to update
+            settings: User settings (defaults are used if omitted)
+        """
+        mark_problem_hard(problem, settings)
@@ -0,0 +1,67 @@
+"""
+Storage interface for DSA Recall.
+
+This module defines the operations every storage backend provides, so
+features that keep files (such as attachments) don't depend on where
+the files actually live.
+"""
+
+from abc import ABC, abstractmethod
+
+
+class Storage(ABC):
+    """
+    Stores files under names chosen by the caller.
+    
+    Each storage instance is scoped to one area (e.g. 'attachments'),
+    so names only need to be unique within that area.
+    """
+    
+    @abstractmethod
+    def save_file(self, name: str, source_path: str) -> None:
+        """
+        Copy a file into storage.
+        
+        Args:
+            name: Name to store the file under
+            source_path: Path of the file to copy
+            
+        Raises:
+            OSError: If the file can't be read or stored
+        """
+    
+    @abstractmethod
+    def exists(self, name: str) -> bool:
+        """
+        Check whether a file is stored under a name.
+        
+        Args:
+            name: Name of the stored file
+            
+        Returns:
+            bool: True if the file exists
+        """
+    
+    @abstractmethod
+    def url(self, name: str) -> str:
+        """
+        Get a URL that opens a stored file (e.g. in the browser).
+        
+        Args:
+            name: Name of the stored file
+            
+        Returns:
+            str: URL of the file
+        """
+    
+    @abstractmethod
+    def delete(self, name: str) -> bool:
+        """
+        Delete a stored file.
+        
+        Args:
+            name: Name of the stored file
+            
+        Returns:
+            bool: True if the file was deleted, False if it didn't exist
+        """
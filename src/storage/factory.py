@@ -0,0 +1,30 @@
+"""
+Storage backend selection.
+
+This module creates the storage backend chosen in the configuration.
+"""
+
+from src.config import get_data_dir, STORAGE_BACKEND, STORAGE_BACKENDS
+from src.errors import ValidationError
+
+from .base import Storage
+from .local import LocalStorage
+
+
+def get_storage(area: str) -> Storage:
+    """
+    Get the configured storage backend for an area.
+    
+    Args:
+        area: Storage area (e.g. 'attachments'), used as the directory
+              name by the local backend
+        
+    Returns:
+        Storage: Storage instance for the area
+        
+    Raises:
+        ValidationError: If the configured backend is unknown
+    """
+    if STORAGE_BACKEND == "local":
+        return LocalStorage(get_data_dir() / area)
+    raise ValidationError(f"Unknown storage backend '{STORAGE_BACKEND}', choose one of: {', '.join(STORAGE_BACKENDS)}")
@@ -0,0 +1,91 @@
+"""
+Local disk storage backend.
+
+This module stores files in a directory on the local disk, inside the
+application's data directory by default.
+"""
+
+import shutil
+from pathlib import Path
+
+from src.errors import ValidationError
+
+from .base import Storage
+
+
+class LocalStorage(Storage):
+    """
+    Stores files in a local directory.
+    
+    Attributes:
+        root: Directory the files are stored in (created when needed)
+    """
+    
+    def __init__(self, root: Path):
+        """
+        Initialize the storage.
+        
+        Args:
+            root: Directory to store files in
+        """
+        self.root = Path(root)
+    
+    def _path(self, name: str) -> Path:
+        """Get the path of a stored file, rejecting names that leave the root."""
+        if not name or Path(name).name != name:
+            raise ValidationError(f"Invalid storage name: {name!r}")
+        return self.root / name
+    
+    def save_file(self, name: str, source_path: str) -> None:
+        """
+        Copy a file into the storage directory.
+        
+        Args:
+            name: Name to store the file under
+            source_path: Path of the file to copy
+            
+        Raises:
+            OSError: If the file can't be read or stored
+        """
+        self.root.mkdir(parents=True, exist_ok=True)
+        shutil.copyfile(Path(source_path).expanduser(), self._path(name))
+    
+    def exists(self, name: str) -> bool:
+        """
+        Check whether a file is stored under a name.
+        
+        Args:
+            name: Name of the stored file
+            
+        Returns:
+            bool: True if the file exists
+        """
+        return self._path(name).is_file()
+    
+    def url(self, name: str) -> str:
+        """
+        Get a file:// URL for a stored file.
+        
+        Args:
+            name: Name of the stored file
+            
+        Returns:
+            str: URL of the file
+        """
+        return self._path(name).resolve().as_uri()
+    
+    def delete(self, name: str) -> bool:
+        """
+        Delete a stored file.
+        
+        Args:
+            name: Name of the stored file
+            
+        Returns:
+            bool: True if the file was deleted, False if it didn't exist
+        """
+        try:
+            self._path(name).unlink()
+            return True
+        except FileNotFoundError:
+            return False
@@ -18,7 +18,9 @@ from .screens.problem_review import ProblemReviewScreen
 from .screens.edit_problem import EditProblemScreen
 
 from src.database.db_manager import DatabaseManager
+from src.utils.notifications import notify_if_leech
 from src.utils.spaced_repetition import auto_mark_overdue_problems
+from src.scheduler.factory import get_scheduler
 from src.config import APP_TITLE
 
 
@@ -81,13 +83,15 @@ class DSARecallApp(App):
         """Auto-mark overdue problems as hard on startup."""
         overdue_problems = self.db.get_overdue_problems()
         if overdue_problems:
-            count = auto_mark_overdue_problems(overdue_problems)
+            settings = self.db.get_settings()
+            marked = auto_mark_overdue_problems(overdue_problems, get_scheduler(settings), settings)
             # Update problems in database
-            for problem in overdue_problems:
+            for problem in marked:
                 self.db.update_problem(problem)
+                notify_if_leech(self.db, problem)
             
-            if count > 0:
-                self.notify(f"Auto-marked {count} overdue problem(s) as hard", severity="warning")
+            if marked:
+                self.notify(f"Auto-marked {len(marked)} overdue problem(s) as hard", severity="warning")
     
     def compose(self) -> ComposeResult:
         """Compose the application layout."""
@@ -14,6 +14,7 @@ from textual.binding import Binding
 from src.database.models import Problem
 from src.utils.spaced_repetition import initialize_new_problem
 from src.utils.editor import edit_approach, edit_code
+from src.utils.validation import problem_errors
 
 
 class AddProblemScreen(Screen):
@@ -205,18 +206,21 @@ class AddProblemScreen(Screen):
         title = self.query_one("#title_input", Input).value.strip()
         link = self.query_one("#link_input", Input).value.strip()
         
-        # Validate
-        if not title:
-            self.app.notify_error("Please enter a problem title")
-            self.query_one("#title_input", Input).focus()
-            return
-        
         # Update problem with form data
         self.problem.title = title
         self.problem.link = link
         
+        # Validate, focusing the first invalid input
+        errors = problem_errors(self.problem)
+        if errors:
+            self.app.notify_error("; ".join(errors.values()))
+            field = next(iter(errors))
+            if field in ('title', 'link'):
+                self.query_one(f"#{field}_input", Input).focus()
+            return
+        
         # Initialize spaced repetition metadata
-        initialize_new_problem(self.problem)
+        initialize_new_problem(self.problem, self.db.get_settings())
         
         try:
             # Save to database
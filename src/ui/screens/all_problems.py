@@ -217,8 +217,8 @@ class AllProblemsScreen(Screen):
         elif self.delete_problem_id == selected_problem.id:
             # Second press - confirm deletion
             try:
-                self.db.delete_problem(selected_problem.id)
-                self.app.notify_success(f"Problem '{selected_problem.title}' deleted")
+                self.db.trash_problem(selected_problem.id)
+                self.app.notify_success(f"Problem '{selected_problem.title}' moved to the trash")
                 self.refresh_data()
                 self.confirm_delete = False
                 self.delete_problem_id = None
@@ -146,8 +146,7 @@ class MainMenuScreen(Screen):
             self.app.go_to_add_problem()
         elif option_index == 2:
             # Review Due Problems
-            due_problems = self.db.get_due_problems()
-            if not due_problems:
+            if not self.db.count_due_problems():
                 self.app.notify("No problems due for review today!", severity="information")
             else:
                 self.app.go_to_review_dashboard()
@@ -11,7 +11,8 @@ from textual.screen import Screen
 from textual.widgets import Static, Button
 from textual.binding import Binding
 
-from src.utils.spaced_repetition import mark_problem_easy, mark_problem_hard
+from src.scheduler.factory import get_scheduler
+from src.utils.notifications import notify_if_streak_milestone, notify_if_leech, notify_review_completed
 from ..widgets.collapsible_text import ProblemDetails
 
 
@@ -177,13 +178,13 @@ class ProblemReviewScreen(Screen):
         try:
             # Apply spaced repetition logic
             old_streak = self.problem.streak_level
-            mark_problem_easy(self.problem)
+            settings = self.db.get_settings()
+            get_scheduler(settings).mark_easy(self.problem, settings)
             
-            # Update in database
-            self.db.update_problem(self.problem)
-            
-            # Record daily review
-            self.db.record_daily_review()
+            # Save the problem and count the review together
+            self.db.save_review(self.problem, 'easy')
+            notify_if_streak_milestone(self.db)
+            notify_review_completed(self.db, self.problem, 'easy')
             
             # Show success message
             new_streak = self.problem.streak_level
@@ -207,13 +208,14 @@ class ProblemReviewScreen(Screen):
         try:
             # Apply spaced repetition logic
             old_streak = self.problem.streak_level
-            mark_problem_hard(self.problem)
-            
-            # Update in database
-            self.db.update_problem(self.problem)
+            settings = self.db.get_settings()
+            get_scheduler(settings).mark_hard(self.problem, settings)
             
-            # Record daily review
-            self.db.record_daily_review()
+            # Save the problem and count the review together
+            self.db.save_review(self.problem, 'hard')
+            notify_if_streak_milestone(self.db)
+            notify_if_leech(self.db, self.problem)
+            notify_review_completed(self.db, self.problem, 'hard')
             
             # Show message
             next_review = self.problem.next_review
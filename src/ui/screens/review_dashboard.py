@@ -103,7 +103,7 @@ class ReviewDashboardScreen(Screen):
     
     def refresh_data(self) -> None:
         """Refresh the list of due problems."""
-        self.due_problems = self.db.get_due_problems()
+        self.due_problems, _ = self.db.get_review_queue()
         self._update_display()
     
     def _update_display(self) -> None:
@@ -11,6 +11,8 @@ from textual.widgets import Static, DataTable
 from textual.binding import Binding
 from datetime import date, timedelta
 
+from src.utils.streaks import StreakService
+
 
 class StreakTrackerScreen(Screen):
     """
@@ -106,6 +108,7 @@ class StreakTrackerScreen(Screen):
         self.db = db_manager
         self.streak_data = []
         self.current_streak = 0
+        self.streak_summary = {}
     
     def compose(self) -> ComposeResult:
         """Compose the streak tracker layout."""
@@ -142,7 +145,8 @@ class StreakTrackerScreen(Screen):
     
     def refresh_data(self) -> None:
         """Refresh streak and activity data."""
-        self.current_streak = self.db.get_current_streak()
+        self.streak_summary = StreakService(self.db).summary()
+        self.current_streak = self.streak_summary['current_streak']
         self.streak_data = self.db.get_streak_data(30)  # Last 30 days
         self._update_display()
     
@@ -185,39 +189,18 @@ class StreakTrackerScreen(Screen):
         avg_per_day = total_reviews / len(self.streak_data) if self.streak_data else 0
         avg_per_active_day = total_reviews / active_days if active_days > 0 else 0
         
-        # Calculate best streak
-        best_streak = self._calculate_best_streak()
-        
         stats_text = (
             f"Total Reviews (30d): {total_reviews} | "
             f"Active Days: {active_days}/30 | "
             f"Avg/Day: {avg_per_day:.1f} | "
             f"Avg/Active Day: {avg_per_active_day:.1f} | "
-            f"Best Streak: {best_streak}"
+            f"Best Streak: {self.streak_summary['longest_streak']} | "
+            f"Freezes: {self.streak_summary['freeze_tokens']} | "
+            f"Badges: {len(self.streak_summary['badges'])}"
         )
         
         total_stats.update(stats_text)
     
-    def _calculate_best_streak(self) -> int:
-        """Calculate the best streak from available data."""
-        if not self.streak_data:
-            return 0
-        
-        # Sort by date (ascending)
-        sorted_data = sorted(self.streak_data, key=lambda x: x['date'])
-        
-        best_streak = 0
-        current_streak = 0
-        
-        for day in sorted_data:
-            if day['problems_reviewed'] > 0:
-                current_streak += 1
-                best_streak = max(best_streak, current_streak)
-            else:
-                current_streak = 0
-        
-        return best_streak
-    
     def _update_activity_table(self) -> None:
         """Update the activity table with recent data."""
         table = self.query_one("#activity_table", DataTable)
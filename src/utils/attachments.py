@@ -0,0 +1,72 @@
+"""
+Attachment utilities.
+
+This module validates files attached to problems and copies them into
+the configured storage, so they stay available even if the original
+file is moved or deleted.
+"""
+
+import uuid
+from pathlib import Path
+
+from src.config import ATTACHMENT_MAX_BYTES, ATTACHMENT_TYPES, ATTACHMENTS_AREA
+from src.database.models import Attachment
+from src.errors import ValidationError
+from src.storage.factory import get_storage
+
+
+def format_size(size_bytes: int) -> str:
+    """
+    Format a file size for display.
+    
+    Args:
+        size_bytes: Size in bytes
+        
+    Returns:
+        str: Size such as '512 B', '12.3 KB' or '2.0 MB'
+    """
+    if size_bytes < 1024:
+        return f"{size_bytes} B"
+    if size_bytes < 1024 * 1024:
+        return f"{size_bytes / 1024:.1f} KB"
+    return f"{size_bytes / (1024 * 1024):.1f} MB"
+
+
+def store_attachment(db_manager, problem_id: int, file_path: str) -> Attachment:
+    """
+    Attach a file to a problem.
+    
+    The file is copied under a unique name into the attachments storage
+    and recorded in the database.
+    
+    Args:
+        db_manager: Database manager instance
+        problem_id: ID of the problem to attach the file to
+        file_path: Path of the file to attach
+        
+    Returns:
+        Attachment: The stored attachment
+        
+    Raises:
+        ValidationError: If the file type isn't allowed or the file is too large
+        OSError: If the file can't be read or copied
+    """
+    source = Path(file_path).expanduser()
+    extension = source.suffix.lower()
+    if extension not in ATTACHMENT_TYPES:
+        raise ValidationError(f"Unsupported file type, allowed: {', '.join(sorted(ATTACHMENT_TYPES))}")
+    
+    size_bytes = source.stat().st_size
+    if size_bytes > ATTACHMENT_MAX_BYTES:
+        raise ValidationError(f"File is larger than {format_size(ATTACHMENT_MAX_BYTES)}")
+    
+    attachment = Attachment(
+        problem_id=problem_id,
+        filename=source.name,
+        stored_name=f"{uuid.uuid4().hex}{extension}",
+        mime_type=ATTACHMENT_TYPES[extension],
+        size_bytes=size_bytes
+    )
+    get_storage(ATTACHMENTS_AREA).save_file(attachment.stored_name, str(source))
+    attachment.id = db_manager.add_attachment(attachment)
+    return attachment
@@ -12,6 +12,8 @@ import platform
 from pathlib import Path
 from typing import Optional
 
+from src.config import LANGUAGE_EXTENSIONS, DEFAULT_CODE_LANGUAGE
+
 
 def get_default_editor() -> str:
     """
@@ -99,7 +101,7 @@ def edit_approach(initial_content: str = "") -> Optional[str]:
     return edit_text(initial_content, ".md")
 
 
-def edit_code(initial_content: str = "", language: str = "cpp") -> Optional[str]:
+def edit_code(initial_content: str = "", language: str = DEFAULT_CODE_LANGUAGE) -> Optional[str]:
     """
     Edit code using external editor with appropriate file extension.
     
@@ -110,22 +112,5 @@ def edit_code(initial_content: str = "", language: str = "cpp") -> Optional[str]
     Returns:
         str: Edited code, None if cancelled
     """
-    # Map common languages to file extensions
-    extensions = {
-        "python": ".py",
-        "java": ".java",
-        "cpp": ".cpp",
-        "c": ".c",
-        "javascript": ".js",
-        "typescript": ".ts",
-        "go": ".go",
-        "rust": ".rs",
-        "ruby": ".rb",
-        "php": ".php",
-        "swift": ".swift",
-        "kotlin": ".kt",
-        "scala": ".scala"
-    }
-    
-    extension = extensions.get(language.lower(), ".txt")
+    extension = LANGUAGE_EXTENSIONS.get(language.lower(), ".txt")
     return edit_text(initial_content, extension)
@@ -0,0 +1,537 @@
+"""
+Data export utilities.
+
+This module writes settings, problems, review history, notes and daily
+activity to JSON or CSV files, problems to Anki-importable flashcards,
+review history in Anki's revlog layout, a flat daily statistics CSV for
+spreadsheets and dashboards, code solutions as a zipped folder tree, and
+shareable problem decks.
+Records are written one at a time while iterating the database, so
+large collections are never held in memory at once.
+"""
+
+import csv
+import html
+import json
+import re
+import zipfile
+from dataclasses import asdict
+from datetime import date, datetime, time
+from pathlib import Path
+from typing import Dict
+
+from src.config import VERSION, LANGUAGE_EXTENSIONS, DEFAULT_CODE_LANGUAGE, DECK_FORMAT
+from src.errors import ValidationError
+from src.utils.leetcode import extract_slug
+from src.utils.languages import normalize_language
+from src.utils.streaks import StreakService
+
+EXPORT_FORMATS = ['json', 'csv']
+
+PROBLEM_FIELDS = ['id', 'title', 'link', 'approach', 'code', 'tags', 'difficulty', 'source', 'companies',
+                  'streak_level', 'next_review', 'last_marked']
+REVIEW_FIELDS = ['problem_id', 'date', 'status']
+NOTE_FIELDS = ['id', 'title', 'body', 'tags', 'problem_id', 'created_at', 'updated_at']
+SOLUTION_FIELDS = ['id', 'problem_id', 'language', 'approach', 'code', 'complexity', 'is_primary',
+                   'streak_level', 'next_review', 'last_marked']
+SOLUTION_REVIEW_FIELDS = ['solution_id', 'date', 'status']
+JOURNAL_FIELDS = ['id', 'problem_id', 'body', 'created_at']
+INTERVIEW_FIELDS = ['id', 'company', 'interview_date', 'round_name', 'outcome', 'notes']
+INTERVIEW_PROBLEM_FIELDS = ['interview_id', 'problem_id']
+COLLECTION_FIELDS = ['id', 'name', 'description', 'created_at']
+COLLECTION_PROBLEM_FIELDS = ['collection_id', 'problem_id', 'position']
+ACTIVITY_FIELDS = ['date', 'problems_reviewed', 'easy_reviewed', 'hard_reviewed']
+SETTING_FIELDS = ['key', 'value']
+
+# Problem fields shared in a deck. Scheduling and review history are
+# personal and always left out; approach and code are added on request.
+DECK_FIELDS = ['title', 'link', 'tags', 'difficulty', 'source', 'companies']
+DECK_SOLUTION_FIELDS = ['approach', 'code']
+
+# Columns of Anki's revlog table, in Anki's order
+REVLOG_FIELDS = ['id', 'cid', 'usn', 'ease', 'ivl', 'lastIvl', 'factor', 'time', 'type']
+
+# Anki answer buttons for graded history entries. Hard resets the streak
+# here, which is what Anki's Again does, and Easy moves on like Good.
+REVLOG_EASE = {'easy': 3, 'hard': 1, 'auto-hard': 1}
+
+# Columns of the daily statistics CSV. Other tools read this file, so
+# columns may be added at the end but never renamed or reordered.
+DAILY_STATS_FIELDS = ['date', 'reviews', 'easy', 'hard', 'retention_rate', 'streak']
+
+
+def _problem_record(problem) -> Dict:
+    """Build an export record for a problem (without its history)."""
+    record = asdict(problem)
+    record.pop('history', None)
+    return {field: record.get(field) for field in PROBLEM_FIELDS}
+
+
+def _record(item, fields) -> Dict:
+    """Build an export record with the given fields of a dataclass instance."""
+    record = asdict(item)
+    return {field: record.get(field) for field in fields}
+
+
+def _write_json_array(json_file, key: str, records, first_section: bool = False) -> None:
+    """
+    Write a named JSON array one record at a time.
+    
+    Args:
+        json_file: Open text file to write to
+        key: Name of the array in the enclosing object
+        records: Iterable of JSON-serialisable records
+        first_section: True if this is the first key in the enclosing object
+    """
+    if not first_section:
+        json_file.write(',\n')
+    json_file.write(f'  {json.dumps(key)}: [')
+    for i, record in enumerate(records):
+        json_file.write(',' if i else '')
+        json_file.write('\n    ' + json.dumps(record, default=str))
+    json_file.write('\n  ]')
+
+
+def export_json(db_manager, file_path: str) -> Path:
+    """
+    Export all data to a single JSON file.
+    
+    Each problem includes its review history. Solutions (with their
+    language track history), journal entries, notes, interviews and
+    collections (with their problem IDs) and the daily activity log are
+    written as separate top-level arrays, and the user's settings
+    (including scheduler parameters) as an object so they can be
+    restored on another machine.
+    
+    Args:
+        db_manager: Database manager instance
+        file_path: Destination file path
+        
+    Returns:
+        Path: Path of the written file
+    """
+    path = Path(file_path).expanduser()
+    
+    def problem_records():
+        for problem in db_manager.iter_problems():
+            record = _problem_record(problem)
+            record['history'] = problem.history_list
+            yield record
+    
+    def solution_records():
+        for solution in db_manager.iter_solutions():
+            record = _record(solution, SOLUTION_FIELDS)
+            record['history'] = solution.history_list
+            yield record
+    
+    def interview_records():
+        for interview in db_manager.get_all_interviews():
+            record = _record(interview, INTERVIEW_FIELDS)
+            record['problem_ids'] = [problem.id for problem in db_manager.get_interview_problems(interview.id)]
+            yield record
+    
+    def collection_records():
+        for collection in db_manager.get_all_collections():
+            record = _record(collection, COLLECTION_FIELDS)
+            record['problem_ids'] = [problem.id for problem in db_manager.get_collection_problems(collection.id)]
+            yield record
+    
+    with open(path, 'w', encoding='utf-8') as json_file:
+        json_file.write('{\n')
+        json_file.write(f'  "version": {json.dumps(VERSION)},\n')
+        json_file.write(f'  "settings": {json.dumps(db_manager.get_settings())},\n')
+        _write_json_array(json_file, 'problems', problem_records(), first_section=True)
+        _write_json_array(json_file, 'solutions', solution_records())
+        _write_json_array(json_file, 'journal', (_record(entry, JOURNAL_FIELDS)
+                                                 for entry in db_manager.iter_journal_entries()))
+        _write_json_array(json_file, 'notes', (_record(note, NOTE_FIELDS) for note in db_manager.iter_notes()))
+        _write_json_array(json_file, 'interviews', interview_records())
+        _write_json_array(json_file, 'collections', collection_records())
+        _write_json_array(json_file, 'activity', db_manager.iter_daily_activity())
+        json_file.write('\n}\n')
+    
+    return path
+
+
+def export_deck(db_manager, file_path: str, name: str, tag: str = "", include_solutions: bool = False) -> Path:
+    """
+    Export problems as a deck that others can import.
+    
+    The deck is a JSON file the importer reads like any other problem
+    list, so importing it creates new problems with fresh schedules.
+    
+    Args:
+        db_manager: Database manager instance
+        file_path: Destination file path
+        name: Deck name shown when the deck is imported
+        tag: Only share problems with this tag, ignoring case ('' for all)
+        include_solutions: Whether approach and code are shared too
+        
+    Returns:
+        Path: Path of the written file
+        
+    Raises:
+        ValidationError: If no problems match the tag
+    """
+    fields = DECK_FIELDS + (DECK_SOLUTION_FIELDS if include_solutions else [])
+    problems = [
+        {field: getattr(problem, field) for field in fields}
+        for problem in db_manager.iter_problems()
+        if not tag or tag.lower() in (problem_tag.lower() for problem_tag in problem.tag_list)
+    ]
+    if not problems:
+        raise ValidationError(f"No problems tagged '{tag}' to share" if tag else "No problems to share")
+    
+    path = Path(file_path).expanduser()
+    with open(path, 'w', encoding='utf-8') as json_file:
+        json.dump({'format': DECK_FORMAT, 'version': VERSION, 'name': name, 'problems': problems},
+                  json_file, indent=2)
+        json_file.write('\n')
+    return path
+
+
+def export_csv(db_manager, directory: str) -> Path:
+    """
+    Export all data as CSV files in a directory.
+    
+    Writes problems.csv, reviews.csv (one row per history entry),
+    solutions.csv, solution_reviews.csv, journal.csv, notes.csv,
+    interviews.csv, interview_problems.csv, collections.csv,
+    collection_problems.csv, activity.csv and settings.csv.
+    
+    Args:
+        db_manager: Database manager instance
+        directory: Destination directory (created if missing)
+        
+    Returns:
+        Path: Path of the directory containing the CSV files
+    """
+    path = Path(directory).expanduser()
+    path.mkdir(parents=True, exist_ok=True)
+    
+    with open(path / 'problems.csv', 'w', encoding='utf-8', newline='') as problems_file, \
+         open(path / 'reviews.csv', 'w', encoding='utf-8', newline='') as reviews_file:
+        problems_writer = csv.DictWriter(problems_file, fieldnames=PROBLEM_FIELDS)
+        reviews_writer = csv.DictWriter(reviews_file, fieldnames=REVIEW_FIELDS, extrasaction='ignore')
+        problems_writer.writeheader()
+        reviews_writer.writeheader()
+        
+        for problem in db_manager.iter_problems():
+            problems_writer.writerow(_problem_record(problem))
+            for entry in problem.history_list:
+                reviews_writer.writerow({'problem_id': problem.id, **entry})
+    
+    with open(path / 'solutions.csv', 'w', encoding='utf-8', newline='') as solutions_file, \
+         open(path / 'solution_reviews.csv', 'w', encoding='utf-8', newline='') as solution_reviews_file:
+        solutions_writer = csv.DictWriter(solutions_file, fieldnames=SOLUTION_FIELDS)
+        solution_reviews_writer = csv.DictWriter(solution_reviews_file, fieldnames=SOLUTION_REVIEW_FIELDS,
+                                                 extrasaction='ignore')
+        solutions_writer.writeheader()
+        solution_reviews_writer.writeheader()
+        
+        for solution in db_manager.iter_solutions():
+            solutions_writer.writerow(_record(solution, SOLUTION_FIELDS))
+            for entry in solution.history_list:
+                solution_reviews_writer.writerow({'solution_id': solution.id, **entry})
+    
+    with open(path / 'journal.csv', 'w', encoding='utf-8', newline='') as journal_file:
+        journal_writer = csv.DictWriter(journal_file, fieldnames=JOURNAL_FIELDS)
+        journal_writer.writeheader()
+        for entry in db_manager.iter_journal_entries():
+            journal_writer.writerow(_record(entry, JOURNAL_FIELDS))
+    
+    with open(path / 'notes.csv', 'w', encoding='utf-8', newline='') as notes_file:
+        notes_writer = csv.DictWriter(notes_file, fieldnames=NOTE_FIELDS)
+        notes_writer.writeheader()
+        for note in db_manager.iter_notes():
+            notes_writer.writerow(_record(note, NOTE_FIELDS))
+    
+    with open(path / 'interviews.csv', 'w', encoding='utf-8', newline='') as interviews_file, \
+         open(path / 'interview_problems.csv', 'w', encoding='utf-8', newline='') as links_file:
+        interviews_writer = csv.DictWriter(interviews_file, fieldnames=INTERVIEW_FIELDS)
+        links_writer = csv.DictWriter(links_file, fieldnames=INTERVIEW_PROBLEM_FIELDS)
+        interviews_writer.writeheader()
+        links_writer.writeheader()
+        
+        for interview in db_manager.get_all_interviews():
+            interviews_writer.writerow(_record(interview, INTERVIEW_FIELDS))
+            for problem in db_manager.get_interview_problems(interview.id):
+                links_writer.writerow({'interview_id': interview.id, 'problem_id': problem.id})
+    
+    with open(path / 'collections.csv', 'w', encoding='utf-8', newline='') as collections_file, \
+         open(path / 'collection_problems.csv', 'w', encoding='utf-8', newline='') as links_file:
+        collections_writer = csv.DictWriter(collections_file, fieldnames=COLLECTION_FIELDS)
+        links_writer = csv.DictWriter(links_file, fieldnames=COLLECTION_PROBLEM_FIELDS)
+        collections_writer.writeheader()
+        links_writer.writeheader()
+        
+        for collection in db_manager.get_all_collections():
+            collections_writer.writerow(_record(collection, COLLECTION_FIELDS))
+            for position, problem in enumerate(db_manager.get_collection_problems(collection.id), 1):
+                links_writer.writerow({'collection_id': collection.id, 'problem_id': problem.id,
+                                       'position': position})
+    
+    with open(path / 'activity.csv', 'w', encoding='utf-8', newline='') as activity_file:
+        activity_writer = csv.DictWriter(activity_file, fieldnames=ACTIVITY_FIELDS)
+        activity_writer.writeheader()
+        for day in db_manager.iter_daily_activity():
+            activity_writer.writerow(day)
+    
+    with open(path / 'settings.csv', 'w', encoding='utf-8', newline='') as settings_file:
+        settings_writer = csv.writer(settings_file)
+        settings_writer.writerow(SETTING_FIELDS)
+        for key, value in db_manager.get_settings().items():
+            settings_writer.writerow([key, value])
+    
+    return path
+
+
+def export_daily_stats_csv(db_manager, file_path: str) -> Path:
+    """
+    Export one row of review statistics per day as a flat CSV file.
+    
+    Every day from the first recorded review up to today is included,
+    with zeros for days without reviews, so the file can be charted
+    directly (e.g. in Google Sheets or a Grafana CSV datasource).
+    
+    Columns (see DAILY_STATS_FIELDS):
+    - date: Day in YYYY-MM-DD format
+    - reviews: Number of reviews that day
+    - easy / hard: Reviews graded Easy / Hard
+    - retention_rate: easy / (easy + hard) rounded to 3 decimals, empty if no graded reviews
+    - streak: Length of the review streak at the end of that day (holidays
+      and frozen days without reviews keep it unchanged)
+    
+    Args:
+        db_manager: Database manager instance
+        file_path: Destination file path
+        
+    Returns:
+        Path: Path of the written file
+    """
+    path = Path(file_path).expanduser()
+    first_day = next(db_manager.iter_daily_activity(), None)
+    start_date = date.fromisoformat(first_day['date']) if first_day else date.today()
+    
+    with open(path, 'w', encoding='utf-8', newline='') as stats_file:
+        writer = csv.DictWriter(stats_file, fieldnames=DAILY_STATS_FIELDS)
+        writer.writeheader()
+        
+        streak = 0
+        excused = StreakService(db_manager).excused_days(start_date, date.today())
+        for day in db_manager.get_activity_range(start_date, date.today()):
+            if day['problems_reviewed'] > 0:
+                streak += 1
+            elif day['date'] not in excused:
+                streak = 0
+            graded = day['easy_reviewed'] + day['hard_reviewed']
+            writer.writerow({
+                'date': day['date'].isoformat(),
+                'reviews': day['problems_reviewed'],
+                'easy': day['easy_reviewed'],
+                'hard': day['hard_reviewed'],
+                'retention_rate': round(day['easy_reviewed'] / graded, 3) if graded else '',
+                'streak': streak,
+            })
+    
+    return path
+
+
+def _revlog_rows(problem):
+    """
+    Yield Anki revlog rows (without the id column) for a problem's graded reviews.
+    
+    Only the review date is stored, not the scheduled interval of past
+    reviews, so ivl is the number of days until the next graded review
+    (or until the currently scheduled one for the latest review).
+    """
+    reviews = [entry for entry in problem.history_list
+               if entry.get('status') in REVLOG_EASE and entry.get('date')]
+    last_interval = 0
+    
+    for index, entry in enumerate(reviews):
+        review_date = date.fromisoformat(entry['date'])
+        if index + 1 < len(reviews):
+            interval = (date.fromisoformat(reviews[index + 1]['date']) - review_date).days
+        elif problem.next_review:
+            interval = max(0, (problem.next_review - review_date).days)
+        else:
+            interval = 0
+        
+        if index == 0:
+            review_type = 0  # learning
+        elif REVLOG_EASE[reviews[index - 1]['status']] == 1:
+            review_type = 2  # relearning after a lapse
+        else:
+            review_type = 1  # review
+        
+        yield review_date, {
+            'cid': problem.id,
+            'usn': -1,
+            'ease': REVLOG_EASE[entry['status']],
+            'ivl': interval,
+            'lastIvl': last_interval,
+            'factor': 0,
+            'time': 0,
+            'type': review_type,
+        }
+        last_interval = interval
+
+
+def export_anki_revlog(db_manager, file_path: str) -> Path:
+    """
+    Export the review history as CSV in the layout of Anki's revlog table.
+    
+    One row is written per Easy/Hard review (resets and snoozes are left
+    out). Problem IDs are used as card IDs. Review times aren't tracked,
+    so each id is noon of the review day in epoch milliseconds (plus a
+    counter to keep it unique), and time taken and ease factor are 0.
+    
+    Args:
+        db_manager: Database manager instance
+        file_path: Destination file path
+        
+    Returns:
+        Path: Path of the written file
+    """
+    path = Path(file_path).expanduser()
+    
+    with open(path, 'w', encoding='utf-8', newline='') as revlog_file:
+        writer = csv.DictWriter(revlog_file, fieldnames=REVLOG_FIELDS)
+        writer.writeheader()
+        
+        sequence = 0
+        for problem in db_manager.iter_problems():
+            for review_date, row in _revlog_rows(problem):
+                timestamp = int(datetime.combine(review_date, time(12)).timestamp() * 1000)
+                writer.writerow({'id': timestamp + sequence, **row})
+                sequence += 1
+    
+    return path
+
+
+def _path_slug(text: str) -> str:
+    """Turn text into a lowercase, dash-separated file or folder name."""
+    return re.sub(r'[^a-z0-9]+', '-', (text or "").lower()).strip('-')
+
+
+def _problem_readme(problem) -> str:
+    """Build the README.md stub stored next to a problem's solutions."""
+    lines = [f"# {problem.title}", ""]
+    if problem.link:
+        lines.append(f"- Link: {problem.link}")
+    if problem.difficulty:
+        lines.append(f"- Difficulty: {problem.difficulty}")
+    if problem.tag_list:
+        lines.append(f"- Tags: {', '.join(problem.tag_list)}")
+    if problem.company_list:
+        lines.append(f"- Companies: {', '.join(problem.company_list)}")
+    lines += ["", "## Approach", "", (problem.approach or "").strip() or "_Not written yet._", ""]
+    return "\n".join(lines)
+
+
+def export_code_zip(db_manager, file_path: str) -> Path:
+    """
+    Export code solutions as a zip of folders ready to commit to a Git repo.
+    
+    Each problem gets a folder named after its first tag and its slug
+    (e.g. array/two-sum/) holding a README.md with the title, link, tags
+    and approach, plus one file per solution. The primary solution is
+    solution.<ext> and the others solution-<id>.<ext>. Problems without
+    stored solutions export their own code, assumed to be
+    DEFAULT_CODE_LANGUAGE. A README.md at the root lists every problem.
+    
+    Args:
+        db_manager: Database manager instance
+        file_path: Destination .zip file path
+        
+    Returns:
+        Path: Path of the written file
+    """
+    path = Path(file_path).expanduser()
+    used_folders = set()
+    index_lines = ["# DSA Solutions", "", f"Exported from DSA Recall {VERSION}.", ""]
+    
+    with zipfile.ZipFile(path, 'w', compression=zipfile.ZIP_DEFLATED) as code_zip:
+        for problem in db_manager.iter_problems():
+            topic = _path_slug(problem.tag_list[0]) if problem.tag_list else ""
+            slug = extract_slug(problem.link) or _path_slug(problem.title) or f"problem-{problem.id}"
+            folder = f"{topic or 'untagged'}/{slug}"
+            if folder in used_folders:
+                folder = f"{folder}-{problem.id}"
+            used_folders.add(folder)
+            
+            code_zip.writestr(f"{folder}/README.md", _problem_readme(problem))
+            
+            solutions = db_manager.get_solutions(problem.id)
+            if solutions:
+                for solution in solutions:
+                    if not solution.code.strip():
+                        continue
+                    name = "solution" if solution.is_primary else f"solution-{solution.id}"
+                    extension = LANGUAGE_EXTENSIONS.get(normalize_language(solution.language), ".txt")
+                    code_zip.writestr(f"{folder}/{name}{extension}", solution.code)
+            elif (problem.code or "").strip():
+                code_zip.writestr(f"{folder}/solution{LANGUAGE_EXTENSIONS[DEFAULT_CODE_LANGUAGE]}", problem.code)
+            
+            index_lines.append(f"- [{problem.title}]({folder}/)")
+        
+        code_zip.writestr("README.md", "\n".join(index_lines) + "\n")
+    
+    return path
+
+
+def _anki_field(text: str) -> str:
+    """
+    Convert plain text into a single-line HTML field for Anki.
+    
+    Args:
+        text: Plain text that may contain newlines, tabs or HTML characters
+        
+    Returns:
+        str: Escaped text with newlines turned into <br> tags
+    """
+    escaped = html.escape(text or "").replace('\t', '    ')
+    return escaped.replace('\r\n', '\n').replace('\n', '<br>')
+
+
+def export_anki_tsv(db_manager, file_path: str) -> Path:
+    """
+    Export problems as Anki flashcards in tab-separated format.
+    
+    The front of each card is the problem title (with its link), the back
+    holds the approach and code. The file header tells Anki to treat the
+    fields as HTML, so it can be imported with File > Import as-is.
+    
+    Args:
+        db_manager: Database manager instance
+        file_path: Destination file path
+        
+    Returns:
+        Path: Path of the written file
+    """
+    path = Path(file_path).expanduser()
+    
+    with open(path, 'w', encoding='utf-8') as tsv_file:
+        tsv_file.write('#separator:tab\n')
+        tsv_file.write('#html:true\n')
+        tsv_file.write('#tags column:3\n')
+        
+        for problem in db_manager.iter_problems():
+            front = _anki_field(problem.title)
+            if problem.link:
+                front += f'<br><a href="{html.escape(problem.link)}">{html.escape(problem.link)}</a>'
+            
+            back_parts = []
+            if (problem.approach or "").strip():
+                back_parts.append(_anki_field(problem.approach.strip()))
+            if (problem.code or "").strip():
+                back_parts.append(f'<pre>{_anki_field(problem.code.rstrip())}</pre>')
+            back = '<hr>'.join(back_parts) or '(no approach recorded)'
+            
+            # Anki tags are space-separated, so spaces inside a tag become dashes
+            anki_tags = ['dsa-recall'] + [tag.replace(' ', '-') for tag in problem.tag_list]
+            
+            tsv_file.write(f'{front}\t{back}\t{" ".join(anki_tags)}\n')
+    
+    return path
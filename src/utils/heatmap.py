@@ -0,0 +1,134 @@
+"""
+Activity heatmap utilities.
+
+This module arranges daily review activity into calendar weeks so it
+can be drawn as a GitHub-style heatmap, and totals it per week or month.
+"""
+
+from datetime import date, timedelta
+from typing import List, Dict, Any, Optional
+
+from src.config import WEEKDAY_NAMES
+from src.errors import ValidationError
+
+# Ways daily activity can be grouped
+GRANULARITIES = ["day", "week", "month"]
+
+
+def week_start_index(week_start: str) -> int:
+    """
+    Convert a week start name to a weekday index (Monday = 0).
+    
+    Args:
+        week_start: Day name such as 'Monday' or 'Sunday'
+        
+    Returns:
+        int: Weekday index, defaulting to Monday for unknown names
+    """
+    for index, name in enumerate(WEEKDAY_NAMES):
+        if name.lower() == (week_start or "").lower():
+            return index
+    return 0
+
+
+def ordered_weekdays(week_start: str) -> List[str]:
+    """
+    List weekday names starting from the configured first day of the week.
+    
+    Args:
+        week_start: Day name such as 'Monday' or 'Sunday'
+        
+    Returns:
+        List of seven weekday names
+    """
+    first = week_start_index(week_start)
+    return WEEKDAY_NAMES[first:] + WEEKDAY_NAMES[:first]
+
+
+def align_to_week_start(day: date, week_start: str) -> date:
+    """
+    Move a date back to the first day of its week.
+    
+    Args:
+        day: Any date
+        week_start: Day name such as 'Monday' or 'Sunday'
+        
+    Returns:
+        date: First day of the week containing the given date
+    """
+    offset = (day.weekday() - week_start_index(week_start)) % 7
+    return day - timedelta(days=offset)
+
+
+def build_week_grid(activity: List[Dict[str, Any]], week_start: str) -> List[List[Optional[Dict[str, Any]]]]:
+    """
+    Arrange zero-filled daily activity into weeks.
+    
+    The first and last weeks are padded with None for days outside the
+    requested range, so every week has exactly seven entries.
+    
+    Args:
+        activity: Consecutive daily entries with a 'date' key (date objects),
+                  as returned by DatabaseManager.get_activity_range
+        week_start: Day name such as 'Monday' or 'Sunday'
+        
+    Returns:
+        List of weeks, each a list of seven day entries (or None)
+    """
+    if not activity:
+        return []
+    
+    first_index = week_start_index(week_start)
+    leading_padding = (activity[0]['date'].weekday() - first_index) % 7
+    days = [None] * leading_padding + list(activity)
+    days += [None] * (-len(days) % 7)
+    
+    return [days[i:i + 7] for i in range(0, len(days), 7)]
+
+
+def aggregate_activity(activity: List[Dict[str, Any]], granularity: str,
+                       week_start: str = "Monday") -> List[Dict[str, Any]]:
+    """
+    Total zero-filled daily activity per day, week or month.
+    
+    Weeks begin on the configured first day of the week. The first and
+    last buckets may be partial if the range starts or ends mid-period,
+    but no bucket in between is ever missing.
+    
+    Args:
+        activity: Consecutive daily entries with a 'date' key (date objects),
+                  as returned by DatabaseManager.get_activity_range
+        granularity: One of GRANULARITIES
+        week_start: Day name such as 'Monday' or 'Sunday'
+        
+    Returns:
+        List of buckets in ascending order, each with start and end dates
+        (inside the range) and summed problems_reviewed, easy_reviewed and
+        hard_reviewed counts
+        
+    Raises:
+        ValidationError: If the granularity is unknown
+    """
+    if granularity not in GRANULARITIES:
+        raise ValidationError(f"Granularity must be one of: {', '.join(GRANULARITIES)}")
+    
+    buckets = []
+    for day in activity:
+        if granularity == "week":
+            key = align_to_week_start(day['date'], week_start)
+        elif granularity == "month":
+            key = day['date'].replace(day=1)
+        else:
+            key = day['date']
+        
+        if not buckets or buckets[-1]['key'] != key:
+            buckets.append({'key': key, 'start': day['date'], 'end': day['date'],
+                            'problems_reviewed': 0, 'easy_reviewed': 0, 'hard_reviewed': 0})
+        bucket = buckets[-1]
+        bucket['end'] = day['date']
+        for field in ('problems_reviewed', 'easy_reviewed', 'hard_reviewed'):
+            bucket[field] += day[field]
+    
+    for bucket in buckets:
+        del bucket['key']
+    return buckets
@@ -0,0 +1,400 @@
+"""
+Bulk import utilities.
+
+This module loads problems from CSV or JSON files, maps arbitrary
+columns onto problem fields, validates each row, detects duplicate
+links and adds the valid problems to the database. Settings saved in
+a JSON export can be restored as well.
+"""
+
+import csv
+import json
+from pathlib import Path
+from typing import List, Dict, Any, Optional, Callable
+
+from src.database.models import Problem, normalize_difficulty, normalize_link
+from src.config import DIFFICULTY_LEVELS, DEFAULT_SETTINGS, DECK_FORMAT
+from src.errors import ValidationError
+from src.utils.spaced_repetition import initialize_new_problem, balance_initial_reviews, parse_setting_value
+from src.utils.sources import detect_source, normalize_source, normalize_companies
+from src.utils.validation import validate_title, validate_link
+
+# Columns understood by the importer
+IMPORT_FIELDS = ['title', 'link', 'approach', 'code', 'tags', 'difficulty', 'source', 'companies']
+
+# Common column names in other spreadsheets, used to guess a mapping
+COLUMN_SYNONYMS = {
+    'title': ['title', 'name', 'problem', 'problem name', 'question'],
+    'link': ['link', 'url', 'problem link', 'href'],
+    'approach': ['approach', 'notes', 'solution notes', 'explanation', 'idea'],
+    'code': ['code', 'solution', 'implementation'],
+    'tags': ['tags', 'topics', 'topic', 'category', 'categories', 'pattern'],
+    'difficulty': ['difficulty', 'level'],
+    'source': ['source', 'platform', 'site', 'judge'],
+    'companies': ['companies', 'company', 'asked by'],
+}
+
+
+def load_rows(file_path: str) -> List[Dict[str, Any]]:
+    """
+    Load raw rows from a CSV or JSON file.
+    
+    CSV files must have a header row. JSON files may contain either a list
+    of objects or an object with a "problems" list.
+    
+    Args:
+        file_path: Path to the file to import
+        
+    Returns:
+        List of row dictionaries
+        
+    Raises:
+        ValidationError: If the file type is unsupported or the content is malformed
+    """
+    path = Path(file_path).expanduser()
+    suffix = path.suffix.lower()
+    
+    if suffix == '.csv':
+        with open(path, 'r', encoding='utf-8-sig', newline='') as csv_file:
+            return list(csv.DictReader(csv_file))
+    
+    if suffix == '.json':
+        with open(path, 'r', encoding='utf-8') as json_file:
+            try:
+                data = json.load(json_file)
+            except json.JSONDecodeError as e:
+                raise ValidationError(f"Invalid JSON: {e}")
+        if isinstance(data, dict):
+            data = data.get('problems', [])
+        if not isinstance(data, list):
+            raise ValidationError("JSON must contain a list of problems")
+        return data
+    
+    raise ValidationError(f"Unsupported file type '{suffix}'. Use .csv or .json")
+
+
+def get_columns(rows: List[Any]) -> List[str]:
+    """
+    Collect the column names used across all rows, in order of appearance.
+    
+    Args:
+        rows: Raw rows loaded from an import file
+        
+    Returns:
+        List of column names
+    """
+    columns = []
+    for row in rows:
+        if not isinstance(row, dict):
+            continue
+        for column in row:
+            if column not in columns:
+                columns.append(column)
+    return columns
+
+
+def guess_mapping(columns: List[str]) -> Dict[str, str]:
+    """
+    Guess which column holds each problem field.
+    
+    Args:
+        columns: Column names found in the import file
+        
+    Returns:
+        dict: Problem field to column name, for fields with a likely match
+    """
+    normalized = {column.strip().lower().replace('_', ' '): column for column in columns}
+    mapping = {}
+    for field in IMPORT_FIELDS:
+        for synonym in COLUMN_SYNONYMS.get(field, [field]):
+            if synonym in normalized:
+                mapping[field] = normalized[synonym]
+                break
+    return mapping
+
+
+def detect_columns(file_path: str, sample_size: int = 3) -> Dict[str, Any]:
+    """
+    Inspect an import file before importing it.
+    
+    This is the first step of importing from an arbitrary spreadsheet: it
+    reports the columns found, a few sample rows and a suggested mapping
+    that can be adjusted and passed to import_problems.
+    
+    Args:
+        file_path: Path to the file to inspect
+        sample_size: Number of sample rows to return
+        
+    Returns:
+        dict: {'columns': [...], 'samples': [...], 'row_count': int,
+               'suggested_mapping': {field: column}}
+    """
+    rows = load_rows(file_path)
+    columns = get_columns(rows)
+    return {
+        'columns': columns,
+        'samples': rows[:sample_size],
+        'row_count': len(rows),
+        'suggested_mapping': guess_mapping(columns),
+    }
+
+
+def apply_mapping(row: Any, mapping: Dict[str, str]) -> Any:
+    """
+    Rename a row's columns to problem fields.
+    
+    Args:
+        row: Raw row loaded from the import file
+        mapping: Problem field to column name
+        
+    Returns:
+        Row with problem field names as keys (non-dict rows are returned as-is)
+    """
+    if not isinstance(row, dict):
+        return row
+    return {field: row.get(column) for field, column in mapping.items() if column}
+
+
+def add_missing_scheme(link: Optional[str]) -> str:
+    """
+    Add https:// to a link saved without a scheme.
+    
+    Older versions stored links as typed, so their exports may hold
+    links like 'leetcode.com/problems/two-sum/'. Anything else is only
+    stripped, and left for validation to judge.
+    
+    Args:
+        link: Link from an import row (may be None)
+        
+    Returns:
+        str: Link with a scheme, or the stripped link if it doesn't look like a web address
+    """
+    link = (link or "").strip()
+    host = link.split('/', 1)[0]
+    if link and '://' not in link and '.' in host and ' ' not in host:
+        return f"https://{link}"
+    return link
+
+
+def validate_row(row: Any) -> str:
+    """
+    Validate a single import row.
+    
+    Args:
+        row: Raw row loaded from the import file
+        
+    Returns:
+        str: Error message, or an empty string if the row is valid
+    """
+    if not isinstance(row, dict):
+        return "Row is not an object"
+    
+    title = row.get('title')
+    if not isinstance(title, str) or not title.strip():
+        return "Title is required"
+    
+    for field in IMPORT_FIELDS:
+        value = row.get(field)
+        if value is not None and not isinstance(value, str):
+            return f"Field '{field}' must be text"
+    
+    error = validate_title(title) or validate_link(add_missing_scheme(row.get('link')))
+    if error:
+        return error
+    
+    difficulty = row.get('difficulty')
+    if difficulty and not normalize_difficulty(difficulty):
+        return f"Difficulty must be one of: {', '.join(DIFFICULTY_LEVELS)}"
+    
+    return ""
+
+
+def import_problems(db_manager, file_path: str, dry_run: bool = False,
+                    mapping: Optional[Dict[str, str]] = None,
+                    progress: Optional[Callable[[int, int], None]] = None) -> Dict[str, Any]:
+    """
+    Import problems from a CSV or JSON file.
+    
+    Rows with a link that already exists in the database (or earlier in the
+    same file) are skipped. First reviews are spread out so that no day gets
+    more new problems than the daily cap allows. In dry-run mode nothing is
+    written, but the summary reports what would have happened.
+    
+    Args:
+        db_manager: Database manager instance
+        file_path: Path to the file to import
+        dry_run: If True, validate only and don't save anything
+        mapping: Problem field to column name (defaults to columns named
+                 exactly like the fields)
+        progress: Called with (rows_processed, total_rows) as rows are processed
+        
+    Returns:
+        dict: Summary with 'created' count, 'skipped' and 'errors' lists of
+              {'row': row_number, 'message': reason}, and 'last_first_review'
+              (the latest first-review date assigned, or None)
+    """
+    rows = load_rows(file_path)
+    
+    existing_links = {
+        normalize_link(add_missing_scheme(problem.link)) for problem in db_manager.get_all_problems() if problem.link
+    }
+    settings = db_manager.get_settings()
+    scheduled_counts = db_manager.get_new_problem_counts()
+    summary = {'created': 0, 'skipped': [], 'errors': [], 'last_first_review': None}
+    
+    # Row numbers are 1-based to match what users see in a spreadsheet
+    for row_number, row in enumerate(rows, 1):
+        if progress is not None:
+            progress(row_number - 1, len(rows))
+        
+        if mapping is not None:
+            row = apply_mapping(row, mapping)
+        
+        error = validate_row(row)
+        if error:
+            summary['errors'].append({'row': row_number, 'message': error})
+            continue
+        
+        link = add_missing_scheme(row.get('link'))
+        if link and normalize_link(link) in existing_links:
+            summary['skipped'].append({'row': row_number, 'message': f"Duplicate link: {link}"})
+            continue
+        
+        problem = Problem(
+            title=row['title'].strip(),
+            link=link,
+            approach=row.get('approach') or '',
+            code=row.get('code') or '',
+            tags=row.get('tags') or '',
+            difficulty=normalize_difficulty(row.get('difficulty')),
+            source=normalize_source(row.get('source')) or detect_source(link),
+            companies=normalize_companies(row.get('companies'))
+        )
+        initialize_new_problem(problem, settings)
+        balance_initial_reviews([problem], scheduled_counts, settings)
+        
+        if not dry_run:
+            db_manager.add_problem(problem)
+        
+        if link:
+            existing_links.add(normalize_link(link))
+        summary['created'] += 1
+        if summary['last_first_review'] is None or problem.next_review > summary['last_first_review']:
+            summary['last_first_review'] = problem.next_review
+    
+    if progress is not None and rows:
+        progress(len(rows), len(rows))
+    
+    return summary
+
+
+def load_settings(file_path: str) -> Dict[str, Any]:
+    """
+    Load the settings saved in a JSON export.
+    
+    Args:
+        file_path: Path to the import file
+        
+    Returns:
+        dict: Setting name to value, or an empty dict if the file has no settings
+        
+    Raises:
+        ValidationError: If the JSON is malformed
+    """
+    path = Path(file_path).expanduser()
+    if path.suffix.lower() != '.json':
+        return {}
+    
+    with open(path, 'r', encoding='utf-8') as json_file:
+        try:
+            data = json.load(json_file)
+        except json.JSONDecodeError as e:
+            raise ValidationError(f"Invalid JSON: {e}")
+    
+    settings = data.get('settings') if isinstance(data, dict) else None
+    return settings if isinstance(settings, dict) else {}
+
+
+def load_deck_info(file_path: str) -> Optional[Dict[str, Any]]:
+    """
+    Read the name and size of a shared deck.
+    
+    Args:
+        file_path: Path to the import file
+        
+    Returns:
+        dict: 'name' and 'problem_count', or None if the file isn't a deck
+        
+    Raises:
+        ValidationError: If the JSON is malformed
+    """
+    path = Path(file_path).expanduser()
+    if path.suffix.lower() != '.json':
+        return None
+    
+    with open(path, 'r', encoding='utf-8') as json_file:
+        try:
+            data = json.load(json_file)
+        except json.JSONDecodeError as e:
+            raise ValidationError(f"Invalid JSON: {e}")
+    
+    if not isinstance(data, dict) or data.get('format') != DECK_FORMAT:
+        return None
+    problems = data.get('problems')
+    return {
+        'name': str(data.get('name') or path.stem),
+        'problem_count': len(problems) if isinstance(problems, list) else 0,
+    }
+
+
+def import_settings(db_manager, settings: Dict[str, Any]) -> Dict[str, Any]:
+    """
+    Store imported settings after validating each one.
+    
+    Args:
+        db_manager: Database manager instance
+        settings: Setting name to value, e.g. from load_settings
+        
+    Returns:
+        dict: Summary with 'applied' (list of setting names) and 'errors'
+              (list of {'key': name, 'message': reason})
+    """
+    summary = {'applied': [], 'errors': []}
+    
+    for key, value in settings.items():
+        if key not in DEFAULT_SETTINGS:
+            summary['errors'].append({'key': key, 'message': "Unknown setting"})
+            continue
+        try:
+            db_manager.set_setting(key, parse_setting_value(key, str(value)))
+        except ValueError as e:
+            summary['errors'].append({'key': key, 'message': str(e)})
+            continue
+        summary['applied'].append(key)
+    
+    return summary
+
+
+def write_error_report(summary: Dict[str, Any], file_path: str) -> Path:
+    """
+    Write the skipped and failed rows of an import to a CSV file.
+    
+    Args:
+        summary: Summary dictionary returned by import_problems
+        file_path: Destination file path
+        
+    Returns:
+        Path: Path of the written report
+    """
+    path = Path(file_path).expanduser()
+    entries = [('skipped', entry) for entry in summary['skipped']] + \
+              [('error', entry) for entry in summary['errors']]
+    entries.sort(key=lambda item: item[1]['row'])
+    
+    with open(path, 'w', encoding='utf-8', newline='') as report_file:
+        writer = csv.writer(report_file)
+        writer.writerow(['row', 'result', 'message'])
+        for result, entry in entries:
+            writer.writerow([entry['row'], result, entry['message']])
+    
+    return path
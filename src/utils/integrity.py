@@ -0,0 +1,144 @@
+"""
+Data integrity checks.
+
+This module scans the database for inconsistent data (orphaned rows,
+unreadable values, streak counts that disagree with review history)
+and can optionally repair what it finds.
+"""
+
+import json
+from datetime import date
+from typing import List, Dict, Any
+
+from src.config import STATUS_ACTIVE, STATUS_INBOX, STATUS_ARCHIVED
+
+# Statuses a problem may have
+VALID_STATUSES = [STATUS_ACTIVE, STATUS_INBOX, STATUS_ARCHIVED]
+
+# History statuses that are also counted in the daily review log
+COUNTED_REVIEW_STATUSES = ['easy', 'hard']
+
+
+def _parse_date(value: Any):
+    """Parse a stored YYYY-MM-DD date, returning None if it's invalid."""
+    try:
+        return date.fromisoformat(value)
+    except (TypeError, ValueError):
+        return None
+
+
+def _issue(check: str, message: str, repairable: bool = True) -> Dict[str, Any]:
+    """Build an issue dictionary."""
+    return {'check': check, 'message': message, 'repairable': repairable, 'repaired': False}
+
+
+def check_integrity(db_manager, repair: bool = False) -> List[Dict[str, Any]]:
+    """
+    Scan the database for integrity problems.
+    
+    Checks for:
+    - solutions whose problem no longer exists (repair: delete them)
+    - notes linked to a missing problem (repair: unlink them)
+    - unreadable review dates (repair: review today / clear last review)
+    - malformed review history (repair: reset it to empty)
+    - unknown problem statuses (repair: make the problem active)
+    - days whose streak counts are lower than the review history shows
+      (repair: raise the counts to match)
+    - problems sharing the same link (reported only)
+    
+    Args:
+        db_manager: Database manager instance
+        repair: If True, fix every repairable issue found
+        
+    Returns:
+        List of issue dictionaries with 'check', 'message', 'repairable'
+        and 'repaired' keys
+    """
+    issues = []
+    
+    for solution_id in db_manager.get_orphaned_solution_ids():
+        issue = _issue('orphaned_solution', f"Solution {solution_id} belongs to a deleted problem")
+        if repair:
+            db_manager.delete_solution(solution_id)
+            issue['repaired'] = True
+        issues.append(issue)
+    
+    for note_id in db_manager.get_orphaned_note_ids():
+        issue = _issue('orphaned_note_link', f"Note {note_id} is linked to a deleted problem")
+        if repair:
+            note = db_manager.get_note(note_id)
+            note.problem_id = None
+            db_manager.update_note(note)
+            issue['repaired'] = True
+        issues.append(issue)
+    
+    # Review counts per day according to the problems' own history
+    history_counts = {}
+    links = {}
+    
+    for row in db_manager.iter_problem_rows():
+        problem_id = row['id']
+        
+        for field, replacement in (('next_review', date.today().isoformat()), ('last_marked', None)):
+            if row[field] and _parse_date(row[field]) is None:
+                issue = _issue('invalid_date', f"Problem {problem_id} has an invalid {field}: {row[field]!r}")
+                if repair:
+                    db_manager.set_problem_field(problem_id, field, replacement)
+                    issue['repaired'] = True
+                issues.append(issue)
+        
+        try:
+            history = json.loads(row['history'] or '[]')
+            if not isinstance(history, list) or not all(isinstance(entry, dict) for entry in history):
+                raise ValueError
+        except ValueError:
+            history = []
+            issue = _issue('malformed_history', f"Problem {problem_id} has unreadable review history")
+            if repair:
+                db_manager.set_problem_field(problem_id, 'history', '[]')
+                issue['repaired'] = True
+            issues.append(issue)
+        
+        for entry in history:
+            status = entry.get('status')
+            if status in COUNTED_REVIEW_STATUSES and _parse_date(entry.get('date')):
+                day_counts = history_counts.setdefault(entry['date'], {'easy': 0, 'hard': 0})
+                day_counts[status] += 1
+        
+        if (row['status'] or STATUS_ACTIVE) not in VALID_STATUSES:
+            issue = _issue('invalid_status', f"Problem {problem_id} has an unknown status: {row['status']!r}")
+            if repair:
+                db_manager.set_problem_field(problem_id, 'status', STATUS_ACTIVE)
+                issue['repaired'] = True
+            issues.append(issue)
+        
+        link = (row['link'] or '').strip()
+        if link:
+            links.setdefault(link, []).append(problem_id)
+    
+    # Deleting a problem removes its history but not the daily counts, so
+    # only days with fewer recorded reviews than the history shows are wrong
+    activity = {day['date']: day for day in db_manager.iter_daily_activity()}
+    for day, counts in sorted(history_counts.items()):
+        recorded = activity.get(day, {'problems_reviewed': 0, 'easy_reviewed': 0, 'hard_reviewed': 0})
+        easy = max(recorded['easy_reviewed'], counts['easy'])
+        hard = max(recorded['hard_reviewed'], counts['hard'])
+        total = max(recorded['problems_reviewed'], easy + hard)
+        if (total, easy, hard) == (recorded['problems_reviewed'], recorded['easy_reviewed'], recorded['hard_reviewed']):
+            continue
+        issue = _issue('streak_mismatch',
+                       f"{day}: {recorded['problems_reviewed']} review(s) recorded, "
+                       f"but the history shows {counts['easy'] + counts['hard']}")
+        if repair:
+            db_manager.set_daily_review_counts(date.fromisoformat(day), total, easy, hard)
+            issue['repaired'] = True
+        issues.append(issue)
+    
+    for link, problem_ids in links.items():
+        if len(problem_ids) > 1:
+            ids = ', '.join(str(problem_id) for problem_id in problem_ids)
+            issues.append(_issue('duplicate_link',
+                                 f"Problems {ids} share the link {link} (merge them from View All Problems)",
+                                 repairable=False))
+    
+    return issues
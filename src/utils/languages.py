@@ -0,0 +1,98 @@
+"""
+Programming language utilities.
+
+This module normalizes language names to the supported slugs (the keys
+of LANGUAGE_EXTENSIONS) and guesses the language of a code snippet when
+none was given.
+"""
+
+import re
+from typing import List
+
+from src.config import LANGUAGE_EXTENSIONS, LANGUAGE_ALIASES
+
+# Patterns that identify each language, most specific languages first.
+# Languages are tried in order and the first with a match wins.
+LANGUAGE_PATTERNS = [
+    ("kotlin", [r'^\s*fun\s+\w+\s*\(', r'\bval\s+\w+\s*[:=]']),
+    ("scala", [r'^\s*def\s+\w+\s*\(.*\)\s*:\s*\w+.*=', r'^\s*object\s+\w+']),
+    ("go", [r'^\s*package\s+\w+\s*$', r'^\s*func\s+(\(\w+\s+\*?\w+\)\s*)?\w+\s*\([^:)]*\)', r':=']),
+    ("swift", [r'^\s*func\s+\w+\s*\(', r'^\s*import\s+Foundation']),
+    ("rust", [r'^\s*(pub\s+)?fn\s+\w+', r'\blet\s+mut\s+', r'\bimpl\s+\w+']),
+    ("php", [r'<\?php', r'\$\w+\s*=']),
+    ("cpp", [r'#include\s*<(iostream|vector|string|bits/stdc\+\+\.h|unordered_map|algorithm)>',
+             r'\bstd::', r'\busing\s+namespace\s+std\b', r'\bvector\s*<', r'^\s*public:']),
+    ("c", [r'#include\s*<\w+\.h>', r'\bprintf\s*\(', r'\bmalloc\s*\(']),
+    ("java", [r'\bpublic\s+(static\s+)?[\w\[\]<>]+\s+\w+\s*\(', r'\bSystem\.out\.', r'^\s*import\s+java\.']),
+    ("typescript", [r'\b(let|const|var)\s+\w+\s*:\s*\w+', r'\bfunction\s+\w+\s*\(.*:\s*\w+',
+                    r'^\s*(export\s+)?interface\s+\w+']),
+    ("javascript", [r'\b(let|const|var)\s+\w+\s*=', r'\bfunction\s+\w+\s*\(', r'=>', r'\bconsole\.log\(']),
+    ("ruby", [r'^\s*def\s+\w+[^:]*$', r'^\s*end\s*$', r'\.each\s+do\b']),
+    ("python", [r'^\s*def\s+\w+\s*\(.*\)\s*(->\s*[\w\[\], ]+)?:\s*$', r'^\s*class\s+\w+(\(.*\))?:\s*$',
+                r'^\s*(from\s+\w+\s+)?import\s+\w+', r'\bself\.']),
+]
+
+
+def get_supported_languages() -> List[str]:
+    """
+    Get the languages code can be stored and exported as.
+    
+    Returns:
+        List of language slugs, sorted alphabetically
+    """
+    return sorted(LANGUAGE_EXTENSIONS)
+
+
+def normalize_language(value: str) -> str:
+    """
+    Normalize a language name to its supported slug.
+    
+    Args:
+        value: Language as entered (case-insensitive, e.g. 'Python3' or 'C++')
+        
+    Returns:
+        str: Slug from LANGUAGE_EXTENSIONS, or '' if unrecognised
+    """
+    value = (value or "").strip().lower()
+    value = LANGUAGE_ALIASES.get(value, value)
+    return value if value in LANGUAGE_EXTENSIONS else ""
+
+
+def detect_language(code: str) -> str:
+    """
+    Guess the language of a code snippet from characteristic syntax.
+    
+    Args:
+        code: Code to inspect
+        
+    Returns:
+        str: Detected language slug, or '' if no language matched
+    """
+    if not (code or "").strip():
+        return ""
+    
+    for language, patterns in LANGUAGE_PATTERNS:
+        if any(re.search(pattern, code, re.MULTILINE) for pattern in patterns):
+            return language
+    return ""
+
+
+def resolve_language(language: str, code: str) -> str:
+    """
+    Pick the language to store for a solution.
+    
+    Names of supported languages are normalized to their slug. Empty
+    languages are detected from the code, and unknown names are kept
+    as entered (lowercased) so nothing the user typed is lost.
+    
+    Args:
+        language: Language as entered (may be empty)
+        code: The solution's code
+        
+    Returns:
+        str: Language to store ('' if none was given or detected)
+    """
+    language = (language or "").strip().lower()
+    if not language:
+        return detect_language(code)
+    return normalize_language(language) or language
@@ -0,0 +1,151 @@
+"""
+LeetCode metadata client.
+
+This module looks up a problem's title, difficulty and topic tags from
+LeetCode's GraphQL API so they can be filled in automatically when a
+leetcode.com link is added. Results are cached on disk, and lookups are
+skipped entirely in offline mode.
+"""
+
+import http.client
+import json
+import re
+import urllib.error
+import urllib.request
+from typing import Optional, Dict, Any
+
+from src.config import OFFLINE_MODE, LEETCODE_GRAPHQL_URL, NETWORK_TIMEOUT_SECONDS, get_cache_dir
+
+CACHE_FILE_NAME = "leetcode.json"
+
+QUESTION_QUERY = """
+query questionData($titleSlug: String!) {
+  question(titleSlug: $titleSlug) {
+    title
+    difficulty
+    topicTags { name slug }
+  }
+}
+"""
+
+_SLUG_PATTERN = re.compile(r'^(?:https?://)?(?:www\.)?leetcode\.(?:com|cn)/problems/([a-z0-9-]+)', re.IGNORECASE)
+
+
+def extract_slug(url: str) -> Optional[str]:
+    """
+    Extract the problem slug from a LeetCode problem URL.
+    
+    Args:
+        url: Problem URL (e.g. https://leetcode.com/problems/two-sum/description/)
+        
+    Returns:
+        str: Problem slug (e.g. 'two-sum'), or None for non-LeetCode URLs
+    """
+    match = _SLUG_PATTERN.match((url or "").strip())
+    return match.group(1).lower() if match else None
+
+
+def is_leetcode_url(url: str) -> bool:
+    """
+    Check whether a URL points to a LeetCode problem.
+    
+    Args:
+        url: URL to check
+        
+    Returns:
+        bool: True if the URL is a LeetCode problem link
+    """
+    return extract_slug(url) is not None
+
+
+def _load_cache() -> Dict[str, Any]:
+    """Load the on-disk metadata cache (empty if missing or unreadable)."""
+    try:
+        with open(get_cache_dir() / CACHE_FILE_NAME, 'r', encoding='utf-8') as cache_file:
+            return json.load(cache_file)
+    except (OSError, json.JSONDecodeError):
+        return {}
+
+
+def _save_cache(cache: Dict[str, Any]) -> None:
+    """Write the metadata cache to disk, ignoring write failures."""
+    try:
+        with open(get_cache_dir() / CACHE_FILE_NAME, 'w', encoding='utf-8') as cache_file:
+            json.dump(cache, cache_file)
+    except OSError:
+        pass
+
+
+def _query_leetcode(slug: str) -> Optional[Dict[str, Any]]:
+    """
+    Query the LeetCode GraphQL API for a problem.
+    
+    Args:
+        slug: Problem slug
+        
+    Returns:
+        dict: Raw 'question' object from the API, or None on any failure
+              (including a response that isn't the expected JSON object)
+    """
+    payload = json.dumps({
+        'query': QUESTION_QUERY,
+        'variables': {'titleSlug': slug},
+    }).encode('utf-8')
+    request = urllib.request.Request(
+        LEETCODE_GRAPHQL_URL,
+        data=payload,
+        headers={
+            'Content-Type': 'application/json',
+            'Referer': f'https://leetcode.com/problems/{slug}/',
+            'User-Agent': 'dsa-recall',
+        },
+    )
+    
+    try:
+        with urllib.request.urlopen(request, timeout=NETWORK_TIMEOUT_SECONDS) as response:
+            data = json.load(response)
+    except (urllib.error.URLError, http.client.HTTPException, OSError, ValueError):
+        return None
+    
+    if not isinstance(data, dict) or not isinstance(data.get('data'), dict):
+        return None
+    question = data['data'].get('question')
+    return question if isinstance(question, dict) else None
+
+
+def fetch_problem_metadata(url: str) -> Optional[Dict[str, Any]]:
+    """
+    Fetch title, difficulty and topic tags for a LeetCode problem URL.
+    
+    Args:
+        url: LeetCode problem URL
+        
+    Returns:
+        dict: {'title': str, 'difficulty': str, 'tags': [str]} or None if the
+              URL isn't a LeetCode link, offline mode is on, or the lookup failed
+    """
+    slug = extract_slug(url)
+    if slug is None:
+        return None
+    
+    cache = _load_cache()
+    if slug in cache:
+        return cache[slug]
+    
+    if OFFLINE_MODE:
+        return None
+    
+    question = _query_leetcode(slug)
+    if not question or not question.get('title'):
+        return None
+    
+    metadata = {
+        'title': question['title'],
+        'difficulty': question.get('difficulty') or '',
+        'tags': [tag['slug'] for tag in question.get('topicTags') or []
+                 if isinstance(tag, dict) and tag.get('slug')],
+    }
+    
+    cache[slug] = metadata
+    _save_cache(cache)
+    return metadata
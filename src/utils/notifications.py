@@ -0,0 +1,244 @@
+"""
+Notification helpers.
+
+This module turns app events (imports and exports finishing, streak
+milestones, leech problems) into in-app notifications, so they can all
+be read in one place. The same events, plus reviews, the daily due
+reminder and streaks at risk or lost, are also sent to the outgoing
+webhooks subscribed to them.
+"""
+
+from datetime import date, datetime, timedelta
+from typing import Dict, Any
+
+from src.config import (
+    NOTIFY_IMPORT_FINISHED, NOTIFY_EXPORT_FINISHED, NOTIFY_STREAK_MILESTONE, NOTIFY_LEECH,
+    EVENT_REVIEW_COMPLETED, EVENT_PROBLEMS_DUE, EVENT_STREAK_BROKEN, EVENT_STREAK_AT_RISK,
+    LEECH_HARD_COUNT, STREAK_RISK_HOUR
+)
+from src.database.models import Problem, holiday_weekdays
+from src.utils.streaks import StreakService
+from src.utils.webhooks import send_event, process_pending_deliveries
+
+# History statuses that count as forgetting a problem
+FAILED_REVIEW_STATUSES = ['hard', 'auto-hard']
+
+
+def notify_import_finished(db_manager, file_path: str, summary: Dict[str, Any]) -> None:
+    """
+    Record that an import finished.
+    
+    Args:
+        db_manager: Database manager instance
+        file_path: File that was imported
+        summary: Summary dictionary returned by import_problems
+    """
+    not_imported = len(summary['skipped']) + len(summary['errors'])
+    message = f"Imported {summary['created']} problem(s) from {file_path}"
+    if not_imported:
+        message += f" ({len(summary['skipped'])} skipped, {len(summary['errors'])} with errors)"
+    db_manager.add_notification(NOTIFY_IMPORT_FINISHED, message)
+    send_event(db_manager, NOTIFY_IMPORT_FINISHED, {
+        'message': message,
+        'file': str(file_path),
+        'created': summary['created'],
+        'skipped': len(summary['skipped']),
+        'errors': len(summary['errors']),
+    })
+
+
+def notify_export_finished(db_manager, path) -> None:
+    """
+    Record that an export (backup) finished.
+    
+    Args:
+        db_manager: Database manager instance
+        path: Path of the written file or directory
+    """
+    message = f"Exported data to {path}"
+    db_manager.add_notification(NOTIFY_EXPORT_FINISHED, message)
+    send_event(db_manager, NOTIFY_EXPORT_FINISHED, {'message': message, 'path': str(path)})
+
+
+def notify_if_streak_milestone(db_manager) -> bool:
+    """
+    Celebrate the current streak the first time it reaches a milestone.
+    
+    Call this after recording a review. It also stores earned streak
+    freezes and badges; each badge is only earned (and celebrated) once.
+    
+    Args:
+        db_manager: Database manager instance
+        
+    Returns:
+        bool: True if a notification was added
+    """
+    streaks = StreakService(db_manager)
+    new_badges = streaks.update()['new_badges']
+    streak = streaks.current_streak()
+    if streak not in new_badges:
+        return False
+    
+    message = f"🔥 {streak}-day review streak!"
+    db_manager.add_notification(NOTIFY_STREAK_MILESTONE, message)
+    send_event(db_manager, NOTIFY_STREAK_MILESTONE, {'message': message, 'streak_days': streak})
+    return True
+
+
+def notify_if_leech(db_manager, problem: Problem) -> bool:
+    """
+    Flag a problem that has just been forgotten LEECH_HARD_COUNT times.
+    
+    Call this after a problem was marked Hard (by the user or automatically).
+    
+    Args:
+        db_manager: Database manager instance
+        problem: Problem that was just marked Hard
+        
+    Returns:
+        bool: True if a notification was added
+    """
+    failures = sum(1 for entry in problem.history_list if entry.get('status') in FAILED_REVIEW_STATUSES)
+    if failures != LEECH_HARD_COUNT:
+        return False
+    
+    message = f"'{problem.title}' has been marked Hard {failures} times. Consider rewriting its approach."
+    db_manager.add_notification(NOTIFY_LEECH, message)
+    send_event(db_manager, NOTIFY_LEECH, {
+        'message': message,
+        'problem_id': problem.id,
+        'title': problem.title,
+        'hard_count': failures,
+    })
+    return True
+
+
+def notify_review_completed(db_manager, problem: Problem, grade: str) -> int:
+    """
+    Send a graded review to the webhooks subscribed to reviews.
+    
+    Call this after the problem's new schedule has been saved.
+    
+    Args:
+        db_manager: Database manager instance
+        problem: Problem that was just reviewed
+        grade: 'easy' or 'hard'
+        
+    Returns:
+        int: Number of webhook deliveries queued
+    """
+    return send_event(db_manager, EVENT_REVIEW_COMPLETED, {
+        'problem_id': problem.id,
+        'title': problem.title,
+        'grade': grade,
+        'streak_level': problem.streak_level,
+        'next_review': problem.next_review.isoformat() if problem.next_review else None,
+    })
+
+
+def notify_problems_due(db_manager) -> int:
+    """
+    Send today's review queue to the webhooks subscribed to it, once a day.
+    
+    Args:
+        db_manager: Database manager instance
+        
+    Returns:
+        int: Number of webhook deliveries queued
+    """
+    today = date.today()
+    if db_manager.has_webhook_event_since(EVENT_PROBLEMS_DUE, today):
+        return 0
+    
+    due_problems = db_manager.get_review_queue()[0]
+    if not due_problems:
+        return 0
+    return send_event(db_manager, EVENT_PROBLEMS_DUE, {
+        'count': len(due_problems),
+        'problems': [{'id': problem.id, 'title': problem.title} for problem in due_problems],
+    })
+
+
+def notify_if_streak_broken(db_manager) -> int:
+    """
+    Tell the subscribed webhooks once that the last review streak ended.
+    
+    A streak has ended when a day that isn't a holiday passed without
+    reviews after the last review day and no streak freeze covered it.
+    
+    Args:
+        db_manager: Database manager instance
+        
+    Returns:
+        int: Number of webhook deliveries queued
+    """
+    last_review = db_manager.get_last_review_date()
+    if last_review is None or db_manager.has_webhook_event_since(EVENT_STREAK_BROKEN, last_review):
+        return 0
+    
+    streaks = StreakService(db_manager)
+    if not streaks.missed_days(last_review):
+        return 0
+    
+    return send_event(db_manager, EVENT_STREAK_BROKEN, {
+        'streak_days': streaks.current_streak(as_of=last_review),
+        'last_review_date': last_review.isoformat(),
+    })
+
+
+def notify_if_streak_at_risk(db_manager, now: datetime = None) -> int:
+    """
+    Warn the subscribed webhooks, once a day, that today's review is missing.
+    
+    The warning is sent from STREAK_RISK_HOUR on, when there is a streak
+    to lose, nothing was reviewed today and today isn't a holiday.
+    
+    Args:
+        db_manager: Database manager instance
+        now: Current time (defaults to now)
+        
+    Returns:
+        int: Number of webhook deliveries queued
+    """
+    now = now or datetime.now()
+    today = now.date()
+    if now.hour < STREAK_RISK_HOUR or db_manager.has_webhook_event_since(EVENT_STREAK_AT_RISK, today):
+        return 0
+    if today.weekday() in holiday_weekdays(db_manager.get_settings()['holidays']):
+        return 0
+    if db_manager.get_activity_range(today, today)[0]['problems_reviewed'] > 0:
+        return 0
+    
+    streaks = StreakService(db_manager)
+    streak = streaks.current_streak(as_of=today - timedelta(days=1))
+    if streak == 0:
+        return 0
+    
+    midnight = datetime.combine(today + timedelta(days=1), datetime.min.time())
+    return send_event(db_manager, EVENT_STREAK_AT_RISK, {
+        'streak_days': streak,
+        'hours_left': int((midnight - now).total_seconds() // 3600),
+        'freeze_tokens': streaks.freeze_tokens(),
+    })
+
+
+def send_scheduled_events(db_manager) -> Dict[str, int]:
+    """
+    Queue the time-based events and send every pending webhook delivery.
+    
+    Run on startup, and by `main.py --notify` for scheduled jobs (e.g. an
+    hourly cron entry) so warnings arrive while the app is closed. Streak
+    freezes are spent first, so a covered day doesn't count as a lost streak.
+    Events queued since the last run (e.g. reviews) are sent here too.
+    
+    Args:
+        db_manager: Database manager instance
+        
+    Returns:
+        dict: {'delivered': int, 'failed': int} for the attempted deliveries
+    """
+    StreakService(db_manager).update()
+    notify_if_streak_broken(db_manager)
+    notify_if_streak_at_risk(db_manager)
+    notify_problems_due(db_manager)
+    return process_pending_deliveries(db_manager)
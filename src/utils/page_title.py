@@ -0,0 +1,69 @@
+"""
+Web page title lookup.
+
+This module fetches the <title> of a web page so quickly-captured links
+get a readable title. LeetCode links should be tried with the richer
+metadata client in src.utils.leetcode first. Lookups are skipped in
+offline mode.
+"""
+
+import html
+import re
+import urllib.error
+import urllib.request
+from typing import Optional
+
+from src.config import OFFLINE_MODE, NETWORK_TIMEOUT_SECONDS
+
+# Only the start of a page is read, the <title> is in the <head>
+MAX_TITLE_BYTES = 64 * 1024
+
+_TITLE_PATTERN = re.compile(r'<title[^>]*>(.*?)</title>', re.IGNORECASE | re.DOTALL)
+
+
+def _download_title(url: str) -> Optional[str]:
+    """
+    Download the beginning of a page and extract its <title>.
+    
+    Args:
+        url: Page URL (http or https)
+        
+    Returns:
+        str: Page title, or None on any failure
+    """
+    request = urllib.request.Request(url, headers={'User-Agent': 'dsa-recall'})
+    try:
+        with urllib.request.urlopen(request, timeout=NETWORK_TIMEOUT_SECONDS) as response:
+            charset = response.headers.get_content_charset() or 'utf-8'
+            raw_content = response.read(MAX_TITLE_BYTES)
+    except (urllib.error.URLError, OSError, ValueError):
+        return None
+    
+    try:
+        content = raw_content.decode(charset, errors='replace')
+    except LookupError:
+        # The page declared a charset Python doesn't know
+        content = raw_content.decode('utf-8', errors='replace')
+    
+    match = _TITLE_PATTERN.search(content)
+    if not match:
+        return None
+    title = ' '.join(html.unescape(match.group(1)).split())
+    return title or None
+
+
+def fetch_page_title(url: str) -> Optional[str]:
+    """
+    Look up the <title> of a problem page.
+    
+    Args:
+        url: Page URL
+        
+    Returns:
+        str: Title, or None if it couldn't be found or offline mode is on
+    """
+    url = (url or "").strip()
+    if OFFLINE_MODE or not url.lower().startswith(('http://', 'https://')):
+        return None
+    
+    return _download_title(url)
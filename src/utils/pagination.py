@@ -0,0 +1,55 @@
+"""
+Pagination utilities.
+
+This module splits long lists into pages so list windows can show them
+a screenful at a time, with the same page metadata everywhere.
+"""
+
+from typing import List, Dict, Any
+
+from src.config import DEFAULT_PAGE_SIZE, MAX_PAGE_SIZE
+from src.errors import ValidationError
+
+
+def paginate(items: List[Any], page: int = 1, page_size: int = DEFAULT_PAGE_SIZE) -> Dict[str, Any]:
+    """
+    Return one page of a list together with page metadata.
+    
+    Pages are numbered from 1. A page past either end is clamped to the
+    first or last page, so a list that shrank (e.g. after a delete) still
+    shows something.
+    
+    Args:
+        items: Full list of items
+        page: Requested page number
+        page_size: Number of items per page (1 to MAX_PAGE_SIZE)
+        
+    Returns:
+        dict: Page with keys:
+            - items: Items on the page
+            - page: Page number actually shown
+            - page_size: Items per page
+            - total_items: Number of items in the full list
+            - total_pages: Number of pages (at least 1)
+            - has_previous / has_next: Whether neighbouring pages exist
+        
+    Raises:
+        ValidationError: If page_size is outside 1 to MAX_PAGE_SIZE
+    """
+    if not 1 <= page_size <= MAX_PAGE_SIZE:
+        raise ValidationError(f"Page size must be between 1 and {MAX_PAGE_SIZE}")
+    
+    total_items = len(items)
+    total_pages = max(1, -(-total_items // page_size))
+    page = min(max(page, 1), total_pages)
+    start = (page - 1) * page_size
+    
+    return {
+        'items': items[start:start + page_size],
+        'page': page,
+        'page_size': page_size,
+        'total_items': total_items,
+        'total_pages': total_pages,
+        'has_previous': page > 1,
+        'has_next': page < total_pages,
+    }
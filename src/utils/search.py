@@ -0,0 +1,102 @@
+"""
+Global search utilities.
+
+This module searches problems, notes and tags in one go and returns
+a single ranked list of results, each labelled with its type.
+"""
+
+from typing import List, Dict, Any
+
+from src.database.models import normalize_search_text
+
+# Scores for where the query was found, higher is better
+SCORE_EXACT_TITLE = 100
+SCORE_TITLE_PREFIX = 75
+SCORE_TITLE_CONTAINS = 50
+SCORE_TAG = 40
+SCORE_CONTENT = 10
+
+
+def score_match(query: str, title: str, content: str = "") -> int:
+    """
+    Score how well a title and its content match a query.
+    
+    Args:
+        query: Normalized search text (see normalize_search_text)
+        title: Title of the item
+        content: Additional searchable text (approach, body, link, tags)
+        
+    Returns:
+        int: Match score (0 if the query doesn't match)
+    """
+    title = normalize_search_text(title)
+    if title == query:
+        return SCORE_EXACT_TITLE
+    if title.startswith(query):
+        return SCORE_TITLE_PREFIX
+    if query in title:
+        return SCORE_TITLE_CONTAINS
+    if query in normalize_search_text(content):
+        return SCORE_CONTENT
+    return 0
+
+
+def global_search(db_manager, query: str, limit: int = 20) -> List[Dict[str, Any]]:
+    """
+    Search problems, notes and tags and rank the combined results.
+    
+    Args:
+        db_manager: Database manager instance
+        query: Text to search for
+        limit: Maximum number of results to return
+        
+    Returns:
+        List of result dictionaries with 'type' ('problem', 'note' or 'tag'),
+        'id' (None for tags), 'title' and 'score', best matches first
+    """
+    query = query.strip()
+    if not query:
+        return []
+    needle = normalize_search_text(query)
+    
+    results = []
+    
+    tag_counts = {}
+    
+    def count_matching_tags(tags):
+        for tag in tags:
+            if needle in normalize_search_text(tag):
+                tag_counts[tag] = tag_counts.get(tag, 0) + 1
+    
+    for problem in db_manager.search_problems(query):
+        content = f"{problem.link or ''} {problem.approach or ''} {problem.tags}"
+        results.append({
+            'type': 'problem',
+            'id': problem.id,
+            'title': problem.title,
+            'score': score_match(needle, problem.title, content)
+        })
+        count_matching_tags(problem.tag_list)
+    
+    for note in db_manager.search_notes(query):
+        content = f"{note.body} {note.tags}"
+        results.append({
+            'type': 'note',
+            'id': note.id,
+            'title': note.title,
+            'score': score_match(needle, note.title, content)
+        })
+        count_matching_tags(note.tag_list)
+    
+    for tag, count in tag_counts.items():
+        results.append({
+            'type': 'tag',
+            'id': None,
+            'title': tag,
+            'score': SCORE_EXACT_TITLE if normalize_search_text(tag) == needle else SCORE_TAG,
+            'count': count
+        })
+    
+    # Best score first, then alphabetically for a stable order
+    results.sort(key=lambda result: (-result['score'], result['title'].lower()))
+    return results[:limit]
@@ -0,0 +1,83 @@
+"""
+Problem source and company helpers.
+
+This module works out which site a problem comes from based on its link,
+and keeps source and company names spelled consistently so they can be
+filtered and counted.
+"""
+
+from typing import List
+from urllib.parse import urlparse
+
+from src.config import PROBLEM_SOURCES, KNOWN_COMPANIES
+from src.database.models import split_tags
+
+
+def detect_source(link: str) -> str:
+    """
+    Work out a problem's source from its link.
+    
+    Args:
+        link: Problem URL (the scheme may be left out)
+        
+    Returns:
+        str: Source name (e.g. 'LeetCode'), or '' if the site is unknown
+    """
+    link = (link or "").strip()
+    if not link:
+        return ""
+    if "://" not in link:
+        link = "https://" + link
+    
+    host = (urlparse(link).hostname or "").lower()
+    for domain, source in PROBLEM_SOURCES.items():
+        if host == domain or host.endswith("." + domain):
+            return source
+    return ""
+
+
+def get_known_sources() -> List[str]:
+    """
+    Get the names of all known sources.
+        
+    Returns:
+        List of source names in the order they are configured, without duplicates
+    """
+    return list(dict.fromkeys(PROBLEM_SOURCES.values()))
+
+
+def normalize_source(value: str) -> str:
+    """
+    Spell a source name consistently.
+    
+    Args:
+        value: Source name as entered
+        
+    Returns:
+        str: The known spelling if the name is a known source (ignoring case),
+             otherwise the stripped input
+    """
+    value = (value or "").strip()
+    for source in get_known_sources():
+        if source.lower() == value.lower():
+            return source
+    return value
+
+
+def normalize_companies(value: str) -> str:
+    """
+    Clean up a comma-separated list of companies.
+    
+    Known companies get their usual spelling and duplicates are dropped.
+    
+    Args:
+        value: Comma-separated company names as entered
+        
+    Returns:
+        str: Comma-separated company names
+    """
+    known = {company.lower(): company for company in KNOWN_COMPANIES}
+    companies = {}
+    for company in split_tags(value):
+        companies.setdefault(company.lower(), known.get(company.lower(), company))
+    return ", ".join(companies.values())
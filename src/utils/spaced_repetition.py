@@ -6,50 +6,224 @@ and managing the spaced repetition system.
 """
 
 from datetime import date, timedelta
-from typing import Tuple
+from typing import Tuple, Dict, Any, List, Optional
 
-from src.config import INITIAL_STREAK_LEVEL, INITIAL_INTERVAL_DAYS, STREAK_MULTIPLIER
-from src.database.models import Problem
+from src.config import (
+    STATUS_ACTIVE, STATUS_INBOX, STATUS_ARCHIVED, DEFAULT_SETTINGS, SETTING_CHOICES,
+    INITIAL_STREAK_LEVEL, STREAK_MULTIPLIER, DIFFICULTY_DELAY_OFFSET_DAYS,
+    SNOOZE_MAX_DAYS, TREND_MIN_REVIEWS, WEEKDAY_NAMES, MASTERY_EASY_REVIEWS, MASTERY_MIN_INTERVAL_DAYS,
+    FSRS_DEFAULT_WEIGHTS, MAX_PAGE_SIZE
+)
+from src.database.models import Problem, Solution, holiday_weekdays
+from src.errors import ValidationError, ConflictError
 
 
-def calculate_next_review_date(streak_level: int, mark_as_easy: bool = True) -> date:
+def resolve_settings(settings: Dict[str, Any] = None) -> Dict[str, Any]:
+    """
+    Fill in defaults for any settings that weren't provided.
+    
+    Args:
+        settings: User settings (e.g. from DatabaseManager.get_settings), or None
+        
+    Returns:
+        dict: Complete settings dictionary
+    """
+    return {**DEFAULT_SETTINGS, **(settings or {})}
+
+
+def parse_setting_value(key: str, raw_value: str) -> Any:
+    """
+    Convert text (user input or an imported value) to the type of a setting.
+    
+    Args:
+        key: Setting name
+        raw_value: Text to convert
+        
+    Returns:
+        Converted value
+        
+    Raises:
+        ValidationError: If the text isn't valid for the setting
+    """
+    default = DEFAULT_SETTINGS[key]
+    if key == 'holidays':
+        return parse_holidays(raw_value)
+    if key == 'fsrs_weights':
+        return parse_fsrs_weights(raw_value)
+    if key == 'page_size':
+        value = int(raw_value)
+        if not 1 <= value <= MAX_PAGE_SIZE:
+            raise ValidationError(f"Value must be between 1 and {MAX_PAGE_SIZE}")
+        return value
+    if key == 'desired_retention':
+        value = float(raw_value)
+        if not 0 < value < 1:
+            raise ValidationError("Value must be between 0 and 1")
+        return value
+    if key in SETTING_CHOICES:
+        for choice in SETTING_CHOICES[key]:
+            if choice.lower() == raw_value.lower():
+                return choice
+        raise ValidationError(f"Choose one of: {', '.join(SETTING_CHOICES[key])}")
+    if isinstance(default, int):
+        value = int(raw_value)
+        if value < 0:
+            raise ValidationError("Value cannot be negative")
+        return value
+    if isinstance(default, float):
+        value = float(raw_value)
+        if value <= 0:
+            raise ValidationError("Value must be greater than zero")
+        return value
+    return type(default)(raw_value)
+
+
+def parse_holidays(raw_value: str) -> str:
+    """
+    Parse a list of recurring no-review weekdays.
+    
+    Day names are matched case-insensitively and may be abbreviated to
+    their first three letters. 'none' (or an empty value) clears the list.
+    
+    Args:
+        raw_value: Comma-separated day names, e.g. 'sat, sun'
+        
+    Returns:
+        str: Canonical comma-separated day names in weekday order
+        
+    Raises:
+        ValidationError: If a day name is unknown or every day is a holiday
+    """
+    if raw_value.strip().lower() in ('', 'none'):
+        return ""
+    
+    weekdays = set()
+    for day in raw_value.split(','):
+        day = day.strip().lower()
+        if not day:
+            continue
+        matches = [index for index, name in enumerate(WEEKDAY_NAMES)
+                   if len(day) >= 3 and name.lower().startswith(day)]
+        if not matches:
+            raise ValidationError(f"Unknown day '{day}'")
+        weekdays.add(matches[0])
+    
+    if len(weekdays) == len(WEEKDAY_NAMES):
+        raise ValidationError("At least one day of the week must allow reviews")
+    return ",".join(WEEKDAY_NAMES[index] for index in sorted(weekdays))
+
+
+def parse_fsrs_weights(raw_value: str) -> str:
+    """
+    Parse a list of FSRS parameters.
+    
+    'default' (or an empty value) clears the list, so the built-in
+    parameters are used.
+    
+    Args:
+        raw_value: Comma-separated numbers, one per FSRS parameter
+        
+    Returns:
+        str: Canonical comma-separated parameters ('' for the defaults)
+        
+    Raises:
+        ValidationError: If a value isn't a number or the count is wrong
+    """
+    if raw_value.strip().lower() in ('', 'default', 'none'):
+        return ""
+    
+    try:
+        weights = [float(value) for value in raw_value.split(',')]
+    except ValueError:
+        raise ValidationError("Parameters must be comma-separated numbers")
+    if len(weights) != len(FSRS_DEFAULT_WEIGHTS):
+        raise ValidationError(f"Expected {len(FSRS_DEFAULT_WEIGHTS)} parameters, got {len(weights)}")
+    return ",".join(f"{weight:g}" for weight in weights)
+
+
+def skip_holidays(day: date, settings: Dict[str, Any] = None) -> date:
+    """
+    Move a review date forward past any recurring no-review days.
+    
+    Args:
+        day: Scheduled review date
+        settings: User settings (defaults are used if omitted)
+        
+    Returns:
+        date: The given date, or the first following day that isn't a holiday
+    """
+    holidays = holiday_weekdays(resolve_settings(settings)['holidays'])
+    while day.weekday() in holidays:
+        day += timedelta(days=1)
+    return day
+
+
+def calculate_next_review_date(streak_level: int, mark_as_easy: bool = True,
+                               settings: Dict[str, Any] = None) -> date:
     """
     Calculate the next review date based on spaced repetition algorithm.
     
     The algorithm uses exponential backoff:
-    - Easy: next_review = today + 2^streak_level days
-    - Hard: streak_level resets to 1, next_review = tomorrow
+    - Easy: next_review = today + 2^streak_level * easy_bonus days (at least 1)
+    - Hard: next_review = today + hard_interval_days
+    Both are capped at max_interval_days when that setting is above 0.
     
     Args:
         streak_level: Current streak level
         mark_as_easy: True for easy review, False for hard review
+        settings: User settings (defaults are used if omitted)
         
     Returns:
         date: Next review date
     """
+    settings = resolve_settings(settings)
     today = date.today()
     
     if mark_as_easy:
         # Easy review: increase interval exponentially
-        interval_days = STREAK_MULTIPLIER ** streak_level
-        return today + timedelta(days=interval_days)
+        interval_days = max(1, round(STREAK_MULTIPLIER ** streak_level * settings['easy_bonus']))
     else:
-        # Hard review: reset to shortest interval
-        return today + timedelta(days=INITIAL_INTERVAL_DAYS)
+        # Hard review: back to a short interval
+        interval_days = settings['hard_interval_days']
+    
+    return today + timedelta(days=cap_interval(interval_days, settings))
 
 
-def mark_problem_easy(problem: Problem) -> None:
+def cap_interval(interval_days: int, settings: Dict[str, Any] = None) -> int:
+    """
+    Limit an interval to the configured maximum.
+    
+    Args:
+        interval_days: Interval in days
+        settings: User settings (defaults are used if omitted)
+        
+    Returns:
+        int: The interval, or max_interval_days if that is set and smaller
+    """
+    max_interval = resolve_settings(settings)['max_interval_days']
+    return min(interval_days, max_interval) if max_interval > 0 else interval_days
+
+
+def mark_problem_easy(problem: Problem, settings: Dict[str, Any] = None) -> None:
     """
     Mark a problem as easy and update spaced repetition metadata.
     
     Args:
-        problem: Problem instance to update
+        problem: Problem instance to update (or a Solution on its language track)
+        settings: User settings (defaults are used if omitted)
     """
+    settings = resolve_settings(settings)
+    
     # Increase streak level
     problem.streak_level += 1
     
-    # Calculate next review date
-    problem.next_review = calculate_next_review_date(problem.streak_level, mark_as_easy=True)
+    # Calculate next review date (the first success uses the configured interval)
+    if problem.streak_level == INITIAL_STREAK_LEVEL + 1:
+        interval_days = cap_interval(settings['first_success_interval_days'], settings)
+        problem.next_review = date.today() + timedelta(days=interval_days)
+    else:
+        problem.next_review = calculate_next_review_date(problem.streak_level, mark_as_easy=True, settings=settings)
+    problem.next_review = skip_holidays(problem.next_review, settings)
     
     # Update last marked date
     problem.last_marked = date.today()
@@ -58,18 +232,29 @@ def mark_problem_easy(problem: Problem) -> None:
     problem.add_history_entry("easy")
 
 
-def mark_problem_hard(problem: Problem) -> None:
+def mark_problem_hard(problem: Problem, settings: Dict[str, Any] = None) -> None:
     """
     Mark a problem as hard and reset spaced repetition metadata.
     
+    With the 'step_back' lapse behavior the streak only drops one level
+    instead of going back to the start.
+    
     Args:
-        problem: Problem instance to update
+        problem: Problem instance to update (or a Solution on its language track)
+        settings: User settings (defaults are used if omitted)
     """
-    # Reset streak level
-    problem.streak_level = INITIAL_STREAK_LEVEL
+    settings = resolve_settings(settings)
+    
+    # Reset (or step back) streak level
+    if settings['lapse_behavior'] == 'step_back':
+        problem.streak_level = max(INITIAL_STREAK_LEVEL, problem.streak_level - 1)
+    else:
+        problem.streak_level = INITIAL_STREAK_LEVEL
     
     # Calculate next review date (short interval)
-    problem.next_review = calculate_next_review_date(problem.streak_level, mark_as_easy=False)
+    problem.next_review = skip_holidays(
+        calculate_next_review_date(problem.streak_level, mark_as_easy=False, settings=settings), settings
+    )
     
     # Update last marked date
     problem.last_marked = date.today()
@@ -78,46 +263,96 @@ def mark_problem_hard(problem: Problem) -> None:
     problem.add_history_entry("hard")
 
 
-def auto_mark_overdue_problems(problems: list[Problem]) -> int:
+def mark_problem_auto_hard(problem: Problem) -> None:
+    """
+    Reset an overdue problem's streak and make it due for review today.
+    
+    The lapse is logged as 'auto-hard' in the problem's history.
+    
+    Args:
+        problem: Problem instance to update
+    """
+    problem.streak_level = INITIAL_STREAK_LEVEL
+    problem.next_review = date.today()
+    problem.add_history_entry("auto-hard")
+
+
+def auto_mark_overdue_problems(problems: list[Problem], scheduler,
+                               settings: Dict[str, Any] = None) -> List[Problem]:
     """
     Automatically mark overdue problems as hard.
     
     Problems that are overdue (next_review < today) are automatically
-    marked as hard to reset their spaced repetition interval.
+    marked as hard to reset their spaced repetition interval. The lapse
+    goes through the active scheduler so its own state is reset too.
+    
+    With a daily review limit, problems that came due yesterday are left
+    alone: they may only have been missed because the limit held them
+    back, and they stay at the front of today's queue instead.
     
     Args:
         problems: List of overdue problems
+        scheduler: Active Scheduler instance
+        settings: User settings (defaults are used if omitted)
         
     Returns:
-        int: Number of problems marked as auto-hard
+        List of problems that were marked as auto-hard
     """
-    count = 0
+    settings = resolve_settings(settings)
     today = date.today()
+    cutoff = today - timedelta(days=1) if settings['max_reviews_per_day'] else today
     
+    marked = []
     for problem in problems:
-        if problem.next_review and problem.next_review < today:
-            # Auto-mark as hard
-            problem.streak_level = INITIAL_STREAK_LEVEL
-            problem.next_review = today
-            problem.add_history_entry("auto-hard")
-            count += 1
+        if problem.next_review and problem.next_review < cutoff:
+            scheduler.mark_lapse(problem)
+            marked.append(problem)
     
-    return count
+    return marked
 
 
 def reset_problem_streak(problem: Problem) -> None:
     """
-    Reset a problem's streak and make it due for review today.
+    Reset a problem's streak and scheduler state and make it due for review today.
     
     Args:
         problem: Problem instance to update
     """
     problem.streak_level = INITIAL_STREAK_LEVEL
+    problem.scheduler_state = "{}"
     problem.next_review = date.today()
     problem.last_marked = date.today()
     problem.add_history_entry("reset")
 
 
+def snooze_problem(problem: Problem, until: date) -> None:
+    """
+    Push a problem's next review to a later date.
+    
+    Unlike a review, snoozing keeps the streak level and last marked date
+    as they are. The snooze is logged in the problem's history.
+    
+    Args:
+        problem: Problem instance to snooze
+        until: New review date
+        
+    Raises:
+        ValidationError: If the date isn't in the future or is more than
+                         SNOOZE_MAX_DAYS away
+        ConflictError: If the problem is already scheduled on or after the date
+    """
+    today = date.today()
+    if until <= today:
+        raise ValidationError("Snooze date must be in the future")
+    if problem.next_review and until <= problem.next_review:
+        raise ConflictError(f"Problem is already scheduled for {problem.next_review}")
+    if (until - today).days > SNOOZE_MAX_DAYS:
+        raise ValidationError(f"Problems can be snoozed for at most {SNOOZE_MAX_DAYS} days")
+    
+    problem.add_history_entry("snooze", until=until.isoformat(), previous=str(problem.next_review or ""))
+    problem.next_review = until
+
+
 def get_streak_statistics(problem: Problem) -> dict:
     """
     Get statistics about a problem's review streak.
@@ -128,10 +363,11 @@ def get_streak_statistics(problem: Problem) -> dict:
     Returns:
         dict: Statistics including streak level, next review, etc.
     """
-    total_reviews = len(problem.history_list)
+    total_reviews = sum(1 for entry in problem.history_list if entry['status'] != 'snooze')
     easy_reviews = sum(1 for entry in problem.history_list if entry['status'] == 'easy')
     hard_reviews = sum(1 for entry in problem.history_list if entry['status'] == 'hard')
     auto_hard_reviews = sum(1 for entry in problem.history_list if entry['status'] == 'auto-hard')
+    snoozes = sum(1 for entry in problem.history_list if entry['status'] == 'snooze')
     
     days_until_review = (problem.next_review - date.today()).days if problem.next_review else 0
     
@@ -141,6 +377,7 @@ def get_streak_statistics(problem: Problem) -> dict:
         'easy_reviews': easy_reviews,
         'hard_reviews': hard_reviews,
         'auto_hard_reviews': auto_hard_reviews,
+        'snoozes': snoozes,
         'last_marked': problem.last_marked,
         'next_review': problem.next_review,
         'days_until_review': days_until_review,
@@ -148,14 +385,249 @@ def get_streak_statistics(problem: Problem) -> dict:
     }
 
 
-def initialize_new_problem(problem: Problem) -> None:
+def get_difficulty_trend(problem: Problem) -> Optional[str]:
+    """
+    Work out whether a problem is getting easier or harder over time.
+    
+    The share of Easy grades in the more recent half of the problem's
+    reviews is compared with the older half.
+    
+    Args:
+        problem: Problem instance
+        
+    Returns:
+        str: 'easier', 'harder' or 'steady', or None if the problem has
+             fewer than TREND_MIN_REVIEWS graded reviews
+    """
+    grades = [entry['status'] == 'easy' for entry in problem.history_list
+              if entry.get('status') in ('easy', 'hard', 'auto-hard')]
+    if len(grades) < TREND_MIN_REVIEWS:
+        return None
+    
+    middle = len(grades) // 2
+    older = grades[:middle]
+    recent = grades[-middle:]
+    change = sum(recent) / len(recent) - sum(older) / len(older)
+    
+    if change > 0:
+        return 'easier'
+    if change < 0:
+        return 'harder'
+    return 'steady'
+
+
+def is_mastery_candidate(problem: Problem) -> bool:
+    """
+    Check whether a problem looks mastered and could leave the rotation.
+    
+    A problem qualifies when its last MASTERY_EASY_REVIEWS graded reviews
+    were all Easy and its current interval is at least
+    MASTERY_MIN_INTERVAL_DAYS long.
+    
+    Args:
+        problem: Problem instance
+        
+    Returns:
+        bool: True if the problem should be suggested for archiving
+    """
+    if problem.status != STATUS_ACTIVE or not problem.next_review or not problem.last_marked:
+        return False
+    if (problem.next_review - problem.last_marked).days < MASTERY_MIN_INTERVAL_DAYS:
+        return False
+    
+    grades = [entry['status'] for entry in problem.history_list
+              if entry.get('status') in ('easy', 'hard', 'auto-hard')]
+    recent = grades[-MASTERY_EASY_REVIEWS:]
+    return len(recent) == MASTERY_EASY_REVIEWS and all(grade == 'easy' for grade in recent)
+
+
+def get_mastery_candidates(problems: List[Problem]) -> List[Problem]:
+    """
+    Pick out the problems that look mastered.
+    
+    Args:
+        problems: Problems to check
+        
+    Returns:
+        List of problems for which is_mastery_candidate is True
+    """
+    return [problem for problem in problems if is_mastery_candidate(problem)]
+
+
+def is_mastered(problem: Problem) -> bool:
+    """
+    Check whether a problem counts as mastered.
+    
+    Problems archived as mastered count, and so do active problems that
+    already qualify for archiving.
+    
+    Args:
+        problem: Problem instance to check
+        
+    Returns:
+        bool: True if the problem is archived or a mastery candidate
+    """
+    return problem.status == STATUS_ARCHIVED or is_mastery_candidate(problem)
+
+
+def get_progress(problems: List[Problem]) -> Dict[str, int]:
+    """
+    Summarize how far along a list of problems is (e.g. a collection).
+    
+    Args:
+        problems: Problems to summarize
+        
+    Returns:
+        dict: 'total', 'reviewed' (reviewed at least once), 'mastered'
+              and 'due' (active problems due today or earlier) counts
+    """
+    today = date.today()
+    return {
+        'total': len(problems),
+        'reviewed': sum(1 for problem in problems if problem.last_marked),
+        'mastered': sum(1 for problem in problems if is_mastered(problem)),
+        'due': sum(1 for problem in problems
+                   if problem.status == STATUS_ACTIVE and problem.next_review and problem.next_review <= today),
+    }
+
+
+def initialize_new_problem(problem: Problem, settings: Dict[str, Any] = None) -> None:
     """
     Initialize spaced repetition metadata for a new problem.
     
+    The first review comes after the configured initial delay, plus a
+    few extra days for easier problems.
+    
     Args:
         problem: New problem instance to initialize
+        settings: User settings (defaults are used if omitted)
     """
+    settings = resolve_settings(settings)
+    interval_days = settings['initial_delay_days'] + DIFFICULTY_DELAY_OFFSET_DAYS.get(problem.difficulty, 0)
     problem.streak_level = INITIAL_STREAK_LEVEL
-    problem.next_review = date.today() + timedelta(days=INITIAL_INTERVAL_DAYS)
+    problem.next_review = skip_holidays(date.today() + timedelta(days=interval_days), settings)
     problem.last_marked = None
     problem.history = "[]"
+
+
+def start_solution_track(solution: Solution, settings: Dict[str, Any] = None) -> None:
+    """
+    Start reviewing a solution on its own language track.
+    
+    The track starts from scratch, with the first review after the
+    configured initial delay.
+    
+    Args:
+        solution: Solution instance to schedule
+        settings: User settings (defaults are used if omitted)
+    """
+    settings = resolve_settings(settings)
+    solution.streak_level = INITIAL_STREAK_LEVEL
+    solution.next_review = skip_holidays(date.today() + timedelta(days=settings['initial_delay_days']), settings)
+    solution.last_marked = None
+    solution.history = "[]"
+    solution.scheduler_state = "{}"
+
+
+def stop_solution_track(solution: Solution) -> None:
+    """
+    Stop reviewing a solution on its own language track.
+    
+    Args:
+        solution: Solution instance to unschedule
+    """
+    solution.streak_level = INITIAL_STREAK_LEVEL
+    solution.next_review = None
+    solution.last_marked = None
+    solution.history = "[]"
+    solution.scheduler_state = "{}"
+
+
+def balance_initial_reviews(problems: List[Problem], scheduled_counts: Dict[date, int],
+                            settings: Dict[str, Any] = None) -> None:
+    """
+    Spread the first reviews of new problems so no day exceeds the daily cap.
+    
+    Each problem keeps its initial review date if that day still has room,
+    otherwise it moves to the next non-holiday day that does. Problems
+    should already be initialized with initialize_new_problem.
+    
+    Args:
+        problems: Newly initialized problems to schedule
+        scheduled_counts: Number of new problems already due on each date
+                          (updated in place as problems are assigned)
+        settings: User settings (defaults are used if omitted)
+    """
+    daily_cap = resolve_settings(settings)['daily_new_cap']
+    if daily_cap <= 0:
+        return
+    
+    for problem in problems:
+        due_date = problem.next_review or date.today()
+        while scheduled_counts.get(due_date, 0) >= daily_cap:
+            due_date = skip_holidays(due_date + timedelta(days=1), settings)
+        problem.next_review = due_date
+        scheduled_counts[due_date] = scheduled_counts.get(due_date, 0) + 1
+
+
+def capture_to_inbox(problem: Problem) -> None:
+    """
+    Put a quickly-captured problem in the inbox without scheduling it.
+    
+    Args:
+        problem: New problem instance to capture
+    """
+    problem.status = STATUS_INBOX
+    problem.streak_level = INITIAL_STREAK_LEVEL
+    problem.next_review = None
+    problem.last_marked = None
+    problem.history = "[]"
+
+
+def promote_from_inbox(problem: Problem, settings: Dict[str, Any] = None) -> bool:
+    """
+    Move a triaged inbox problem into the review schedule.
+    
+    A problem counts as triaged once it has both tags and an approach.
+    
+    Args:
+        problem: Inbox problem to promote
+        settings: User settings (defaults are used if omitted)
+        
+    Returns:
+        bool: True if the problem was promoted, False if it still needs triage
+    """
+    if not problem.tag_list or not (problem.approach or "").strip():
+        return False
+    
+    initialize_new_problem(problem, settings)
+    problem.status = STATUS_ACTIVE
+    return True
+
+
+def archive_problem(problem: Problem) -> None:
+    """
+    Take a problem out of the review rotation without deleting it.
+    
+    Its streak and history are kept so it can be brought back later.
+    
+    Args:
+        problem: Problem instance to archive
+    """
+    problem.status = STATUS_ARCHIVED
+
+
+def unarchive_problem(problem: Problem) -> None:
+    """
+    Put an archived problem back into the review rotation.
+    
+    If its review date passed while it was archived, it becomes due today
+    instead of being treated as overdue.
+    
+    Args:
+        problem: Archived problem instance to restore
+    """
+    problem.status = STATUS_ACTIVE
+    today = date.today()
+    if problem.next_review is None or problem.next_review < today:
+        problem.next_review = today
@@ -0,0 +1,221 @@
+"""
+Review streak helpers.
+
+A streak counts consecutive days with at least one review. Recurring
+holidays and days covered by a streak freeze don't end it, but don't add
+to it either. A freeze is earned every STREAK_FREEZE_EARN_DAYS streak
+days (up to STREAK_FREEZE_MAX unused at once) and is spent automatically
+on a missed day, and every length in STREAK_MILESTONES a streak reaches
+is kept as a badge.
+"""
+
+from datetime import date, timedelta
+from typing import Dict, Any, List, Optional, Set
+
+from src.config import STREAK_MILESTONES, STREAK_FREEZE_EARN_DAYS, STREAK_FREEZE_MAX
+from src.database.models import holiday_weekdays
+
+
+class StreakService:
+    """
+    Computes streaks from the daily review log and keeps freezes and
+    badges up to date.
+    """
+    
+    def __init__(self, db_manager):
+        """
+        Initialize the streak service.
+        
+        Args:
+            db_manager: Database manager instance
+        """
+        self.db_manager = db_manager
+    
+    def _reviewed_days(self) -> Set[date]:
+        """
+        Get every day with at least one review.
+        
+        Returns:
+            Set of dates
+        """
+        return {date.fromisoformat(day['date']) for day in self.db_manager.iter_daily_activity()
+                if day['problems_reviewed'] > 0}
+    
+    def _frozen_days(self) -> Set[date]:
+        """
+        Get the missed days covered by a streak freeze.
+        
+        Returns:
+            Set of dates
+        """
+        return {freeze['used_on'] for freeze in self.db_manager.get_streak_freezes() if freeze['used_on']}
+    
+    def excused_days(self, start_date: date, end_date: date) -> Set[date]:
+        """
+        Get the days in a range that don't end a streak without reviews.
+        
+        Args:
+            start_date: First day of the range (inclusive)
+            end_date: Last day of the range (inclusive)
+            
+        Returns:
+            Set of holidays and frozen days in the range
+        """
+        holidays = holiday_weekdays(self.db_manager.get_settings()['holidays'])
+        excused = {day for day in self._frozen_days() if start_date <= day <= end_date}
+        day = start_date
+        while day <= end_date:
+            if day.weekday() in holidays:
+                excused.add(day)
+            day += timedelta(days=1)
+        return excused
+    
+    def missed_days(self, last_review: date, today: date = None) -> List[date]:
+        """
+        Get the days since the last review that end the streak.
+        
+        Today doesn't count yet, since there is still time to review.
+        
+        Args:
+            last_review: Last day with a review
+            today: Current day (defaults to today)
+            
+        Returns:
+            List of missed days that are neither holidays nor frozen, oldest first
+        """
+        today = today or date.today()
+        first_day, last_day = last_review + timedelta(days=1), today - timedelta(days=1)
+        excused = self.excused_days(first_day, last_day)
+        return [first_day + timedelta(days=offset) for offset in range((last_day - first_day).days + 1)
+                if first_day + timedelta(days=offset) not in excused]
+    
+    def current_streak(self, as_of: date = None) -> int:
+        """
+        Calculate the current consecutive streak of days with reviews.
+        
+        Args:
+            as_of: Last day of the streak (defaults to today)
+            
+        Returns:
+            int: Number of consecutive days with at least one review
+        """
+        reviewed = self._reviewed_days()
+        if not reviewed:
+            return 0
+        
+        current_date = as_of or date.today()
+        excused = self.excused_days(min(reviewed), current_date)
+        streak = 0
+        while current_date >= min(reviewed):
+            if current_date in reviewed:
+                streak += 1
+            elif current_date not in excused:
+                break
+            current_date -= timedelta(days=1)
+        return streak
+    
+    def longest_streak(self) -> int:
+        """
+        Calculate the longest run of consecutive days with reviews ever.
+        
+        Returns:
+            int: Length of the longest streak in days
+        """
+        reviewed = sorted(self._reviewed_days())
+        if not reviewed:
+            return 0
+        
+        excused = self.excused_days(reviewed[0], reviewed[-1])
+        longest = 0
+        current = 0
+        previous_date = None
+        for day_date in reviewed:
+            gap = [previous_date + timedelta(days=offset)
+                   for offset in range(1, (day_date - previous_date).days)] if previous_date else []
+            if previous_date is not None and all(gap_day in excused for gap_day in gap):
+                current += 1
+            else:
+                current = 1
+            longest = max(longest, current)
+            previous_date = day_date
+        return longest
+    
+    def freeze_tokens(self) -> int:
+        """
+        Count the streak freezes that can still be spent.
+        
+        Returns:
+            int: Number of unused freezes
+        """
+        return sum(1 for freeze in self.db_manager.get_streak_freezes() if freeze['used_on'] is None)
+    
+    def next_milestone(self, streak: int) -> Optional[int]:
+        """
+        Get the next milestone a streak can reach.
+        
+        Args:
+            streak: Streak length in days
+            
+        Returns:
+            int: Next length from STREAK_MILESTONES, or None past the last one
+        """
+        return next((days for days in STREAK_MILESTONES if days > streak), None)
+    
+    def update(self, today: date = None) -> Dict[str, Any]:
+        """
+        Spend freezes on missed days and store newly earned freezes and badges.
+        
+        Missed days are only covered if there are enough freezes for all
+        of them; otherwise the streak has ended and the freezes are kept.
+        Running this again changes nothing, so it is run after every
+        review and on startup.
+        
+        Args:
+            today: Current day (defaults to today)
+            
+        Returns:
+            dict: frozen_days (days covered by this run), freezes_earned (int)
+            and new_badges (milestones reached for the first time)
+        """
+        today = today or date.today()
+        result = {'frozen_days': [], 'freezes_earned': 0, 'new_badges': []}
+        last_review = self.db_manager.get_last_review_date()
+        if last_review is None:
+            return result
+        
+        missed = self.missed_days(last_review, today)
+        if missed and len(missed) <= self.freeze_tokens():
+            self.db_manager.use_streak_freezes(missed)
+            result['frozen_days'] = missed
+        
+        streak = self.current_streak(as_of=last_review)
+        if (streak % STREAK_FREEZE_EARN_DAYS == 0 and self.freeze_tokens() < STREAK_FREEZE_MAX
+                and self.db_manager.add_streak_freeze(last_review)):
+            result['freezes_earned'] = 1
+        
+        # Milestones from streaks before badges existed are dated when first seen
+        longest = self.longest_streak()
+        badges = self.db_manager.get_streak_badges()
+        for days in STREAK_MILESTONES:
+            if days <= longest and days not in badges and self.db_manager.add_streak_badge(days, today):
+                result['new_badges'].append(days)
+        return result
+    
+    def summary(self) -> Dict[str, Any]:
+        """
+        Collect everything about the streak for display.
+        
+        Returns:
+            dict: current_streak, longest_streak, freeze_tokens, frozen_days
+            (sorted dates), badges (milestone to the day it was reached) and
+            next_milestone (None past the last one)
+        """
+        current_streak = self.current_streak()
+        return {
+            'current_streak': current_streak,
+            'longest_streak': self.longest_streak(),
+            'freeze_tokens': self.freeze_tokens(),
+            'frozen_days': sorted(self._frozen_days()),
+            'badges': self.db_manager.get_streak_badges(),
+            'next_milestone': self.next_milestone(current_streak),
+        }
@@ -0,0 +1,104 @@
+"""
+Automatic tag suggestion utilities.
+
+This module proposes topic tags for problems by matching keywords from
+the tag taxonomy against the problem's title, approach and code.
+"""
+
+import re
+from typing import List, Dict
+
+from src.config import TAG_TAXONOMY
+from src.database.models import Problem
+
+
+def _contains_keyword(text: str, keyword: str) -> bool:
+    """
+    Check whether a keyword appears in text as a whole word or phrase.
+    
+    Args:
+        text: Lowercase text to search
+        keyword: Lowercase keyword or phrase
+        
+    Returns:
+        bool: True if the keyword appears at a word boundary
+    """
+    return re.search(r'\b' + re.escape(keyword), text) is not None
+
+
+def suggest_tags(problem: Problem, max_tags: int = 5) -> List[str]:
+    """
+    Suggest tags for a problem based on its text.
+    
+    Matches in the title and approach count more than matches in code,
+    so explanations drive the suggestions rather than variable names.
+    
+    Args:
+        problem: Problem to suggest tags for
+        max_tags: Maximum number of tags to return
+        
+    Returns:
+        List of suggested tags, best match first, excluding tags the
+        problem already has
+    """
+    title_and_approach = f"{problem.title} {problem.approach}".lower()
+    code = (problem.code or "").lower()
+    existing = {tag.lower() for tag in problem.tag_list}
+    
+    scores = {}
+    for tag, keywords in TAG_TAXONOMY.items():
+        if tag in existing:
+            continue
+        score = 0
+        for keyword in keywords:
+            if _contains_keyword(title_and_approach, keyword):
+                score += 2
+            elif _contains_keyword(code, keyword):
+                score += 1
+        if score:
+            scores[tag] = score
+    
+    ranked = sorted(scores, key=lambda tag: (-scores[tag], tag))
+    return ranked[:max_tags]
+
+
+def suggest_tags_for_untagged(db_manager) -> Dict[int, List[str]]:
+    """
+    Suggest tags for every problem that has no tags yet.
+    
+    Args:
+        db_manager: Database manager instance
+        
+    Returns:
+        dict: Mapping of problem ID to suggested tags (problems without
+              any suggestion are left out)
+    """
+    suggestions = {}
+    for problem in db_manager.get_untagged_problems():
+        tags = suggest_tags(problem)
+        if tags:
+            suggestions[problem.id] = tags
+    return suggestions
+
+
+def apply_tag_suggestions(db_manager, suggestions: Dict[int, List[str]]) -> int:
+    """
+    Save suggested tags onto their problems.
+    
+    Args:
+        db_manager: Database manager instance
+        suggestions: Mapping of problem ID to tags, as returned by
+                     suggest_tags_for_untagged
+        
+    Returns:
+        int: Number of problems updated
+    """
+    updated = 0
+    for problem_id, tags in suggestions.items():
+        problem = db_manager.get_problem(problem_id)
+        if problem is None:
+            continue
+        problem.tags = ", ".join(problem.tag_list + [tag for tag in tags if tag not in problem.tag_list])
+        db_manager.update_problem(problem)
+        updated += 1
+    return updated
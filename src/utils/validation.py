@@ -0,0 +1,153 @@
+"""
+Field validation rules.
+
+This module checks the fields of problems and solutions before they are
+stored. Each rule returns an error message (empty when the value is
+fine), so input prompts can show it next to the field; the *_errors
+functions collect them per field for whole records.
+"""
+
+from typing import Dict, Optional
+from urllib.parse import urlparse
+
+from src.config import DIFFICULTY_LEVELS, PROBLEM_TITLE_MAX_LENGTH, LINK_SCHEMES
+from src.database.models import Problem, Solution
+from src.errors import ValidationError
+from src.utils.languages import get_supported_languages, normalize_language
+
+
+def validate_title(title: str) -> str:
+    """
+    Check a problem title.
+    
+    Args:
+        title: Title as entered
+        
+    Returns:
+        str: Error message, or an empty string if the title is valid
+    """
+    title = (title or "").strip()
+    if not title:
+        return "Title is required"
+    if len(title) > PROBLEM_TITLE_MAX_LENGTH:
+        return f"Title must be at most {PROBLEM_TITLE_MAX_LENGTH} characters"
+    return ""
+
+
+def validate_link(link: str) -> str:
+    """
+    Check a problem link. An empty link is allowed.
+    
+    Args:
+        link: Link as entered
+        
+    Returns:
+        str: Error message, or an empty string if the link is valid
+    """
+    link = (link or "").strip()
+    if not link:
+        return ""
+    parsed = urlparse(link)
+    if parsed.scheme.lower() not in LINK_SCHEMES or not parsed.netloc:
+        return f"Link must be a web address starting with {' or '.join(f'{scheme}://' for scheme in LINK_SCHEMES)}"
+    return ""
+
+
+def validate_difficulty(difficulty: str) -> str:
+    """
+    Check a stored difficulty. An empty difficulty is allowed.
+    
+    Args:
+        difficulty: Difficulty level
+        
+    Returns:
+        str: Error message, or an empty string if the difficulty is valid
+    """
+    if difficulty and difficulty not in DIFFICULTY_LEVELS:
+        return f"Difficulty must be one of: {', '.join(DIFFICULTY_LEVELS)}"
+    return ""
+
+
+def validate_language(language: str) -> str:
+    """
+    Check a solution language. An empty language is allowed.
+    
+    Args:
+        language: Language name or alias (e.g. 'python3')
+        
+    Returns:
+        str: Error message, or an empty string if the language is supported
+    """
+    if language and not normalize_language(language):
+        return f"Language must be one of: {', '.join(get_supported_languages())}"
+    return ""
+
+
+def problem_errors(problem: Problem, stored: Optional[Problem] = None) -> Dict[str, str]:
+    """
+    Check every validated field of a problem.
+    
+    Args:
+        problem: Problem to check
+        stored: The problem as currently saved, if any. Fields that still
+                hold the saved value are not checked, so values saved
+                before these rules existed don't block other changes
+        
+    Returns:
+        dict: Field name to error message (empty if the problem is valid)
+    """
+    errors = {
+        'title': validate_title(problem.title),
+        'link': validate_link(problem.link),
+        'difficulty': validate_difficulty(problem.difficulty),
+    }
+    return {
+        field: error for field, error in errors.items()
+        if error and not (stored and getattr(stored, field) == getattr(problem, field))
+    }
+
+
+def solution_errors(solution: Solution) -> Dict[str, str]:
+    """
+    Check every validated field of a solution.
+    
+    Args:
+        solution: Solution to check
+        
+    Returns:
+        dict: Field name to error message (empty if the solution is valid)
+    """
+    errors = {'language': validate_language(solution.language)}
+    return {field: error for field, error in errors.items() if error}
+
+
+def validate_problem(problem: Problem, stored: Optional[Problem] = None) -> None:
+    """
+    Reject a problem with invalid fields.
+    
+    Args:
+        problem: Problem to check
+        stored: The problem as currently saved, if any (unchanged fields
+                are not checked)
+        
+    Raises:
+        ValidationError: With the field errors if any field is invalid
+    """
+    errors = problem_errors(problem, stored)
+    if errors:
+        raise ValidationError(errors=errors)
+
+
+def validate_solution(solution: Solution) -> None:
+    """
+    Reject a solution with invalid fields.
+    
+    Args:
+        solution: Solution to check
+        
+    Raises:
+        ValidationError: With the field errors if any field is invalid
+    """
+    errors = solution_errors(solution)
+    if errors:
+        raise ValidationError(errors=errors)
@@ -0,0 +1,229 @@
+"""
+Outgoing webhook helpers.
+
+Events are queued once for every active webhook subscribed to them and
+sent through the webhook's channel (signed JSON, or a Discord or Telegram
+message, see src/notifications). Queuing never waits on the network:
+deliveries are sent when pending deliveries are processed (on startup
+and by `main.py --notify`) or resent from the delivery log. Deliveries
+that fail stay pending and are retried with exponential backoff.
+"""
+
+import json
+import urllib.error
+import urllib.request
+from datetime import datetime, timedelta, timezone
+from typing import Dict, Any, Optional, Tuple
+
+from src.config import OFFLINE_MODE, NETWORK_TIMEOUT_SECONDS, WEBHOOK_MAX_ATTEMPTS, WEBHOOK_RETRY_BASE_SECONDS
+from src.database.models import Webhook, WebhookDelivery
+from src.notifications.factory import get_channel
+
+
+def rfc3339_utc(moment: datetime) -> str:
+    """
+    Format a local time as an RFC 3339 UTC timestamp for payloads.
+    
+    Args:
+        moment: Naive local time
+        
+    Returns:
+        str: Timestamp such as '2024-01-15T18:30:00Z'
+    """
+    return moment.astimezone(timezone.utc).strftime('%Y-%m-%dT%H:%M:%SZ')
+
+
+def retry_delay(attempts: int) -> timedelta:
+    """
+    Get how long to wait before retrying a failed delivery.
+    
+    Args:
+        attempts: Number of attempts made so far (at least 1)
+        
+    Returns:
+        timedelta: WEBHOOK_RETRY_BASE_SECONDS, doubled for every earlier attempt
+    """
+    return timedelta(seconds=WEBHOOK_RETRY_BASE_SECONDS * 2 ** (attempts - 1))
+
+
+def queue_event(db_manager, event: str, data: Dict[str, Any], now: datetime = None) -> int:
+    """
+    Queue an event for every active webhook subscribed to it.
+    
+    Args:
+        db_manager: Database manager instance
+        event: Event name (one of WEBHOOK_EVENTS)
+        data: Event details sent in the payload's 'data' field
+        now: When the event happened (defaults to now)
+        
+    Returns:
+        int: Number of deliveries queued
+    """
+    now = now or datetime.now()
+    payload = json.dumps({
+        'event': event,
+        'created_at': rfc3339_utc(now),
+        'data': data,
+    }, ensure_ascii=False)
+    
+    webhooks = db_manager.get_all_webhooks(event=event)
+    for webhook in webhooks:
+        db_manager.add_webhook_delivery(WebhookDelivery(
+            webhook_id=webhook.id, event=event, payload=payload, created_at=now, next_attempt_at=now
+        ))
+    return len(webhooks)
+
+
+def post_payload(webhook: Webhook, delivery: WebhookDelivery) -> Tuple[int, str]:
+    """
+    POST a delivery to its webhook through the webhook's channel.
+    
+    A webhook whose channel can't build the request (a ValidationError,
+    e.g. for an unknown channel) counts as a failed attempt.
+    
+    Args:
+        webhook: Webhook to send to
+        delivery: Delivery to send
+        
+    Returns:
+        tuple: (HTTP status or 0 if there was no response, error message or '' on success)
+    """
+    try:
+        request = get_channel(webhook.channel).build_request(webhook, delivery)
+        with urllib.request.urlopen(request, timeout=NETWORK_TIMEOUT_SECONDS) as response:
+            return response.status, ''
+    except urllib.error.HTTPError as e:
+        return e.code, f"HTTP {e.code} {e.reason}"
+    except (urllib.error.URLError, OSError, ValueError) as e:
+        return 0, str(getattr(e, 'reason', e))
+
+
+def attempt_delivery(db_manager, delivery: WebhookDelivery, webhook: Webhook,
+                     now: datetime = None) -> bool:
+    """
+    Try to send a delivery once and store the result.
+    
+    A failed delivery is scheduled for a retry, or marked 'failed' once it
+    has been tried WEBHOOK_MAX_ATTEMPTS times.
+    
+    Args:
+        db_manager: Database manager instance
+        delivery: Pending delivery to send
+        webhook: Webhook it belongs to
+        now: Current time (defaults to now)
+        
+    Returns:
+        bool: True if the webhook accepted the delivery
+    """
+    now = now or datetime.now()
+    delivery.attempts += 1
+    delivery.response_code, delivery.last_error = post_payload(webhook, delivery)
+    
+    if not delivery.last_error:
+        delivery.status = 'delivered'
+        delivery.next_attempt_at = None
+    elif delivery.attempts >= WEBHOOK_MAX_ATTEMPTS:
+        delivery.status = 'failed'
+        delivery.next_attempt_at = None
+    else:
+        delivery.next_attempt_at = now + retry_delay(delivery.attempts)
+    
+    db_manager.update_webhook_delivery(delivery)
+    return delivery.status == 'delivered'
+
+
+def process_pending_deliveries(db_manager, now: datetime = None) -> Dict[str, int]:
+    """
+    Send every pending delivery that is due for an attempt.
+    
+    Nothing is sent in offline mode, and deliveries to paused webhooks
+    wait until the webhook is resumed.
+    
+    Args:
+        db_manager: Database manager instance
+        now: Current time (defaults to now)
+        
+    Returns:
+        dict: {'delivered': int, 'failed': int} attempts made in this run
+    """
+    result = {'delivered': 0, 'failed': 0}
+    if OFFLINE_MODE:
+        return result
+    
+    webhooks = {webhook.id: webhook for webhook in db_manager.get_all_webhooks()}
+    for delivery in db_manager.get_pending_webhook_deliveries(now):
+        webhook = webhooks.get(delivery.webhook_id)
+        if webhook is None or not webhook.is_active:
+            continue
+        if attempt_delivery(db_manager, delivery, webhook, now):
+            result['delivered'] += 1
+        else:
+            result['failed'] += 1
+    return result
+
+
+def send_event(db_manager, event: str, data: Dict[str, Any]) -> int:
+    """
+    Queue an event for the subscribed webhooks.
+    
+    The deliveries are sent the next time pending deliveries are
+    processed, so recording an event never waits on the network.
+    
+    Args:
+        db_manager: Database manager instance
+        event: Event name (one of WEBHOOK_EVENTS)
+        data: Event details sent in the payload's 'data' field
+        
+    Returns:
+        int: Number of deliveries queued for the event
+    """
+    return queue_event(db_manager, event, data)
+
+
+def retry_delivery(db_manager, delivery: WebhookDelivery) -> Optional[bool]:
+    """
+    Send a failed or pending delivery again right away.
+    
+    Args:
+        db_manager: Database manager instance
+        delivery: Delivery to resend
+        
+    Returns:
+        bool: True if it was delivered, or None if its webhook no longer exists
+    """
+    webhook = db_manager.get_webhook(delivery.webhook_id)
+    if webhook is None:
+        return None
+    
+    delivery.status = 'pending'
+    delivery.attempts = 0
+    return attempt_delivery(db_manager, delivery, webhook)
+
+
+def send_test_event(db_manager, webhook: Webhook) -> WebhookDelivery:
+    """
+    Send a 'test' event to a single webhook right away.
+    
+    A failed test is logged as 'failed' instead of being retried.
+    
+    Args:
+        db_manager: Database manager instance
+        webhook: Webhook to test
+        
+    Returns:
+        WebhookDelivery: The logged delivery ('delivered' if the webhook accepted it)
+    """
+    now = datetime.now()
+    delivery = WebhookDelivery(
+        webhook_id=webhook.id,
+        event='test',
+        payload=json.dumps({'event': 'test', 'created_at': rfc3339_utc(now), 'data': {}}),
+        created_at=now,
+        next_attempt_at=now
+    )
+    delivery.id = db_manager.add_webhook_delivery(delivery)
+    if not attempt_delivery(db_manager, delivery, webhook, now):
+        delivery.status = 'failed'
+        delivery.next_attempt_at = None
+        db_manager.update_webhook_delivery(delivery)
+    return delivery